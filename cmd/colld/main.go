@@ -57,6 +57,7 @@ func main() {
 	)
 	rootCmd.AddCommand(genutilcli.ValidateGenesisCmd(ctx, cdc, app.ModuleBasics))
 	rootCmd.AddCommand(AddGenesisAccountCmd(ctx, cdc, app.DefaultNodeHome, app.DefaultCLIHome))
+	rootCmd.AddCommand(RepairGenesisOwnersCmd(ctx, cdc, app.DefaultNodeHome))
 	rootCmd.AddCommand(flags.NewCompletionCmd(rootCmd, true))
 	// rootCmd.AddCommand(testnetCmd(ctx, cdc, app.ModuleBasics, auth.GenesisAccountIterator{}))
 	// rootCmd.AddCommand(replayCmd())
@@ -80,8 +81,13 @@ func newApp(logger log.Logger, db dbm.DB, traceStore io.Writer) abci.Application
 		cache = store.NewCommitKVStoreCacheManager()
 	}
 
+	skipUpgradeHeights := make(map[int64]bool)
+	for _, h := range viper.GetIntSlice(server.FlagUnsafeSkipUpgrades) {
+		skipUpgradeHeights[int64(h)] = true
+	}
+
 	return app.NewCollectablesApp(
-		logger, db, traceStore, true, invCheckPeriod,
+		logger, db, traceStore, true, skipUpgradeHeights, invCheckPeriod,
 		baseapp.SetPruning(store.NewPruningOptionsFromString(viper.GetString("pruning"))),
 		baseapp.SetMinGasPrices(viper.GetString(server.FlagMinGasPrices)),
 		baseapp.SetHaltHeight(viper.GetUint64(server.FlagHaltHeight)),
@@ -95,7 +101,7 @@ func exportAppStateAndTMValidators(
 ) (json.RawMessage, []tmtypes.GenesisValidator, error) {
 
 	if height != -1 {
-		gapp := app.NewCollectablesApp(logger, db, traceStore, false, uint(1))
+		gapp := app.NewCollectablesApp(logger, db, traceStore, false, map[int64]bool{}, uint(1))
 		err := gapp.LoadHeight(height)
 		if err != nil {
 			return nil, nil, err
@@ -103,6 +109,6 @@ func exportAppStateAndTMValidators(
 		return gapp.ExportAppStateAndValidators(forZeroHeight, jailWhiteList)
 	}
 
-	gapp := app.NewCollectablesApp(logger, db, traceStore, true, uint(1))
+	gapp := app.NewCollectablesApp(logger, db, traceStore, true, map[int64]bool{}, uint(1))
 	return gapp.ExportAppStateAndValidators(forZeroHeight, jailWhiteList)
 }
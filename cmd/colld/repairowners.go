@@ -0,0 +1,79 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/tendermint/tendermint/libs/cli"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+
+	nft "github.com/tosch110/collectables/x/collectables"
+)
+
+const flagOmitOwners = "omit-owners"
+
+// RepairGenesisOwnersCmd returns repair-genesis-owners cobra Command.
+func RepairGenesisOwnersCmd(ctx *server.Context, cdc *codec.Codec, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "repair-genesis-owners [genesis-file]",
+		Short: "Rebuild the NFT owners of a genesis file from the owner of each NFT",
+		Long: `Rebuild the NFT owners of a genesis file from the owner of each NFT of its collections,
+so that an exported genesis whose owners disagree with the collections can be imported again.
+The genesis file of the node is used if none is given. With --omit-owners the owners are
+removed instead, they are then derived from the collections when the chain starts.
+`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			config := ctx.Config
+			config.SetRoot(viper.GetString(cli.HomeFlag))
+
+			genFile := config.GenesisFile()
+			if len(args) == 1 {
+				genFile = args[0]
+			}
+			appState, genDoc, err := genutil.GenesisStateFromGenFile(cdc, genFile)
+			if err != nil {
+				return fmt.Errorf("failed to unmarshal genesis state: %w", err)
+			}
+
+			var nftGenState nft.GenesisState
+			if err := cdc.UnmarshalJSON(appState[nft.ModuleName], &nftGenState); err != nil {
+				return fmt.Errorf("failed to unmarshal %s genesis state: %w", nft.ModuleName, err)
+			}
+
+			if viper.GetBool(flagOmitOwners) {
+				nftGenState.Owners = []nft.Owner{}
+			} else {
+				nftGenState.Owners = nft.DeriveOwners(nftGenState.Collections)
+			}
+			if err := nft.ValidateGenesis(nftGenState); err != nil {
+				return fmt.Errorf("invalid %s genesis state: %w", nft.ModuleName, err)
+			}
+
+			nftGenStateBz, err := cdc.MarshalJSON(nftGenState)
+			if err != nil {
+				return fmt.Errorf("failed to marshal %s genesis state: %w", nft.ModuleName, err)
+			}
+
+			appState[nft.ModuleName] = nftGenStateBz
+
+			appStateJSON, err := cdc.MarshalJSON(appState)
+			if err != nil {
+				return fmt.Errorf("failed to marshal application genesis state: %w", err)
+			}
+
+			genDoc.AppState = appStateJSON
+			return genutil.ExportGenesisFile(genDoc, genFile)
+		},
+	}
+
+	cmd.Flags().String(cli.HomeFlag, defaultNodeHome, "node's home directory")
+	cmd.Flags().Bool(flagOmitOwners, false, "remove the owners instead of rebuilding them")
+
+	return cmd
+}
@@ -23,45 +23,199 @@ func (am OverrideNFTModule) NewHandler() sdk.Handler {
 		case nft.MsgSendNFT:
 			result, err := nft.HandleMsgSendNFT(ctx, msg, k)
 			if err != nil {
-				return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest,
+				return nil, sdkerrors.Wrap(err,
 					fmt.Sprintf("Sending not successful %s : %T", types.ModuleName, msg))
 			}
 			return result, nil
 		case nft.MsgEditNFTMetadata:
 			result, err := nft.HandleMsgEditNFTMetadata(ctx, msg, k)
 			if err != nil {
-				return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest,
+				return nil, sdkerrors.Wrap(err,
 					fmt.Sprintf("Edit Metadata not successful %s : %T", types.ModuleName, msg))
 			}
 			return result, nil
 		case nft.MsgEditNFTPrice:
 			result, err := nft.HandleMsgEditNFTPrice(ctx, msg, k)
 			if err != nil {
-				return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest,
+				return nil, sdkerrors.Wrap(err,
 					fmt.Sprintf("Edit Price not successful %s : %T", types.ModuleName, msg))
 			}
 			return result, nil
 		case nft.MsgMintNFT:
 			result, err := nft.HandleMsgMintNFT(ctx, msg, k)
 			if err != nil {
-				return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest,
+				return nil, sdkerrors.Wrap(err,
 					fmt.Sprintf("Mint NFT not successful %s : %T", types.ModuleName, msg))
 			}
 			return result, nil
+		case nft.MsgBurnNFT:
+			result, err := nft.HandleMsgBurnNFT(ctx, msg, k)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err,
+					fmt.Sprintf("Burn NFT not successful %s : %T", types.ModuleName, msg))
+			}
+			return result, nil
 		case nft.MsgBuyNFT:
 			result, err := nft.HandleMsgBuyNFT(ctx, msg, k)
 			if err != nil {
-				return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest,
+				return nil, sdkerrors.Wrap(err,
 					fmt.Sprintf("Edit Price not successful %s : %T", types.ModuleName, msg))
 			}
 			return result, nil
 		case nft.MsgChallengeNFT:
 			result, err := nft.HandleMsgChallengeNFT(ctx, msg, k)
 			if err != nil {
-				return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest,
+				return nil, sdkerrors.Wrap(err,
 					fmt.Sprintf("Challenge NFT not successful %s : %T", types.ModuleName, msg))
 			}
 			return result, nil
+		case nft.MsgCreateCollection:
+			result, err := nft.HandleMsgCreateCollection(ctx, msg, k)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err,
+					fmt.Sprintf("Create Collection not successful %s : %T", types.ModuleName, msg))
+			}
+			return result, nil
+		case nft.MsgFreezeMetadata:
+			result, err := nft.HandleMsgFreezeMetadata(ctx, msg, k)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err,
+					fmt.Sprintf("Freeze Metadata not successful %s : %T", types.ModuleName, msg))
+			}
+			return result, nil
+		case nft.MsgRevealCollection:
+			result, err := nft.HandleMsgRevealCollection(ctx, msg, k)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err,
+					fmt.Sprintf("Reveal Collection not successful %s : %T", types.ModuleName, msg))
+			}
+			return result, nil
+		case nft.MsgFreezeNFT:
+			result, err := nft.HandleMsgFreezeNFT(ctx, msg, k)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err,
+					fmt.Sprintf("Freeze NFT not successful %s : %T", types.ModuleName, msg))
+			}
+			return result, nil
+		case nft.MsgUnfreezeNFT:
+			result, err := nft.HandleMsgUnfreezeNFT(ctx, msg, k)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err,
+					fmt.Sprintf("Unfreeze NFT not successful %s : %T", types.ModuleName, msg))
+			}
+			return result, nil
+		case nft.MsgClawbackNFT:
+			result, err := nft.HandleMsgClawbackNFT(ctx, msg, k)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err,
+					fmt.Sprintf("Clawback NFT not successful %s : %T", types.ModuleName, msg))
+			}
+			return result, nil
+		case nft.MsgRentNFT:
+			result, err := nft.HandleMsgRentNFT(ctx, msg, k)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err,
+					fmt.Sprintf("Rent NFT not successful %s : %T", types.ModuleName, msg))
+			}
+			return result, nil
+		case nft.MsgRequestLoan:
+			result, err := nft.HandleMsgRequestLoan(ctx, msg, k)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err,
+					fmt.Sprintf("Request Loan not successful %s : %T", types.ModuleName, msg))
+			}
+			return result, nil
+		case nft.MsgCancelLoan:
+			result, err := nft.HandleMsgCancelLoan(ctx, msg, k)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err,
+					fmt.Sprintf("Cancel Loan not successful %s : %T", types.ModuleName, msg))
+			}
+			return result, nil
+		case nft.MsgFundLoan:
+			result, err := nft.HandleMsgFundLoan(ctx, msg, k)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err,
+					fmt.Sprintf("Fund Loan not successful %s : %T", types.ModuleName, msg))
+			}
+			return result, nil
+		case nft.MsgRepayLoan:
+			result, err := nft.HandleMsgRepayLoan(ctx, msg, k)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err,
+					fmt.Sprintf("Repay Loan not successful %s : %T", types.ModuleName, msg))
+			}
+			return result, nil
+		case nft.MsgFractionalize:
+			result, err := nft.HandleMsgFractionalize(ctx, msg, k)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err,
+					fmt.Sprintf("Fractionalize NFT not successful %s : %T", types.ModuleName, msg))
+			}
+			return result, nil
+		case nft.MsgRedeem:
+			result, err := nft.HandleMsgRedeem(ctx, msg, k)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err,
+					fmt.Sprintf("Redeem NFT Shares not successful %s : %T", types.ModuleName, msg))
+			}
+			return result, nil
+		case nft.MsgBuyout:
+			result, err := nft.HandleMsgBuyout(ctx, msg, k)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err,
+					fmt.Sprintf("Buyout NFT not successful %s : %T", types.ModuleName, msg))
+			}
+			return result, nil
+		case nft.MsgWrapNFTs:
+			result, err := nft.HandleMsgWrapNFTs(ctx, msg, k)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err,
+					fmt.Sprintf("Wrap NFTs not successful %s : %T", types.ModuleName, msg))
+			}
+			return result, nil
+		case nft.MsgUnwrapBundle:
+			result, err := nft.HandleMsgUnwrapBundle(ctx, msg, k)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err,
+					fmt.Sprintf("Unwrap Bundle not successful %s : %T", types.ModuleName, msg))
+			}
+			return result, nil
+		case nft.MsgProposeSwap:
+			result, err := nft.HandleMsgProposeSwap(ctx, msg, k)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err,
+					fmt.Sprintf("Propose Swap not successful %s : %T", types.ModuleName, msg))
+			}
+			return result, nil
+		case nft.MsgAcceptSwap:
+			result, err := nft.HandleMsgAcceptSwap(ctx, msg, k)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err,
+					fmt.Sprintf("Accept Swap not successful %s : %T", types.ModuleName, msg))
+			}
+			return result, nil
+		case nft.MsgCancelSwap:
+			result, err := nft.HandleMsgCancelSwap(ctx, msg, k)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err,
+					fmt.Sprintf("Cancel Swap not successful %s : %T", types.ModuleName, msg))
+			}
+			return result, nil
+		case nft.MsgEquip:
+			result, err := nft.HandleMsgEquip(ctx, msg, k)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err,
+					fmt.Sprintf("Equip not successful %s : %T", types.ModuleName, msg))
+			}
+			return result, nil
+		case nft.MsgUnequip:
+			result, err := nft.HandleMsgUnequip(ctx, msg, k)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err,
+					fmt.Sprintf("Unequip not successful %s : %T", types.ModuleName, msg))
+			}
+			return result, nil
 		default:
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest,
 				fmt.Sprintf("Challenge NFT not successful %s : %T", types.ModuleName, msg))
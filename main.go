@@ -15,15 +15,20 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/cosmos/cosmos-sdk/x/crisis"
 	distr "github.com/cosmos/cosmos-sdk/x/distribution"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
+	"github.com/cosmos/cosmos-sdk/x/gov"
 	"github.com/cosmos/cosmos-sdk/x/mint"
 	"github.com/cosmos/cosmos-sdk/x/params"
+	paramsclient "github.com/cosmos/cosmos-sdk/x/params/client"
 	"github.com/cosmos/cosmos-sdk/x/slashing"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/cosmos/cosmos-sdk/x/supply"
+	"github.com/cosmos/cosmos-sdk/x/upgrade"
+	upgradeclient "github.com/cosmos/cosmos-sdk/x/upgrade/client"
 	nft "github.com/tosch110/collectables/x/collectables"
 )
 
@@ -44,10 +49,14 @@ var (
 		staking.AppModuleBasic{},
 		mint.AppModuleBasic{},
 		distr.AppModuleBasic{},
+		gov.NewAppModuleBasic(
+			paramsclient.ProposalHandler, distr.ProposalHandler, upgradeclient.ProposalHandler,
+		),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
 		slashing.AppModuleBasic{},
 		supply.AppModuleBasic{},
+		upgrade.AppModuleBasic{},
 		nft.AppModuleBasic{},
 	)
 
@@ -56,8 +65,10 @@ var (
 		auth.FeeCollectorName:     nil,
 		distr.ModuleName:          nil,
 		mint.ModuleName:           {supply.Minter},
+		gov.ModuleName:            {supply.Burner},
 		staking.BondedPoolName:    {supply.Burner, supply.Staking},
 		staking.NotBondedPoolName: {supply.Burner, supply.Staking},
+		nft.ModuleName:            {supply.Minter, supply.Burner},
 	}
 )
 
@@ -67,6 +78,7 @@ func MakeCodec() *codec.Codec {
 	var cdc = codec.New()
 
 	ModuleBasics.RegisterCodec(cdc)
+	vesting.RegisterCodec(cdc)
 	sdk.RegisterCodec(cdc)
 	codec.RegisterCrypto(cdc)
 	codec.RegisterEvidences(cdc)
@@ -93,8 +105,10 @@ type CollectablesApp struct {
 	slashingKeeper slashing.Keeper
 	mintKeeper     mint.Keeper
 	distrKeeper    distr.Keeper
+	govKeeper      gov.Keeper
 	crisisKeeper   crisis.Keeper
 	paramsKeeper   params.Keeper
+	upgradeKeeper  upgrade.Keeper
 	nftKeeper      nft.Keeper
 
 	// the module manager
@@ -106,7 +120,7 @@ type CollectablesApp struct {
 
 // NewCollectablesApp is a constructor function for CollectablesApp
 func NewCollectablesApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest bool,
-	invCheckPeriod uint, baseAppOptions ...func(*bam.BaseApp)) *CollectablesApp {
+	skipUpgradeHeights map[int64]bool, invCheckPeriod uint, baseAppOptions ...func(*bam.BaseApp)) *CollectablesApp {
 
 	// First define the top level codec that will be shared by the different modules
 	cdc := MakeCodec()
@@ -119,7 +133,7 @@ func NewCollectablesApp(logger log.Logger, db dbm.DB, traceStore io.Writer, load
 	keys := sdk.NewKVStoreKeys(
 		bam.MainStoreKey, auth.StoreKey, staking.StoreKey,
 		supply.StoreKey, mint.StoreKey, distr.StoreKey, slashing.StoreKey,
-		nft.StoreKey, params.StoreKey,
+		gov.StoreKey, nft.StoreKey, params.StoreKey, upgrade.StoreKey,
 	)
 	tkeys := sdk.NewTransientStoreKeys(staking.TStoreKey, params.TStoreKey)
 
@@ -140,7 +154,9 @@ func NewCollectablesApp(logger log.Logger, db dbm.DB, traceStore io.Writer, load
 	mintSubspace := app.paramsKeeper.Subspace(mint.DefaultParamspace)
 	distrSubspace := app.paramsKeeper.Subspace(distr.DefaultParamspace)
 	slashingSubspace := app.paramsKeeper.Subspace(slashing.DefaultParamspace)
+	govSubspace := app.paramsKeeper.Subspace(gov.DefaultParamspace).WithKeyTable(gov.ParamKeyTable())
 	crisisSubspace := app.paramsKeeper.Subspace(crisis.DefaultParamspace)
+	nftSubspace := app.paramsKeeper.Subspace(nft.DefaultParamspace)
 
 	// add keepers
 	app.accountKeeper = auth.NewAccountKeeper(app.cdc, keys[auth.StoreKey], authSubspace, auth.ProtoBaseAccount)
@@ -156,10 +172,32 @@ func NewCollectablesApp(logger log.Logger, db dbm.DB, traceStore io.Writer, load
 		app.cdc, keys[slashing.StoreKey], &stakingKeeper, slashingSubspace,
 	)
 	app.crisisKeeper = crisis.NewKeeper(crisisSubspace, invCheckPeriod, app.supplyKeeper, auth.FeeCollectorName)
+	app.upgradeKeeper = upgrade.NewKeeper(skipUpgradeHeights, keys[upgrade.StoreKey], app.cdc)
+
+	// register the proposal types
+	govRouter := gov.NewRouter()
+	govRouter.AddRoute(gov.RouterKey, gov.ProposalHandler).
+		AddRoute(params.RouterKey, params.NewParamChangeProposalHandler(app.paramsKeeper)).
+		AddRoute(distr.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.distrKeeper)).
+		AddRoute(upgrade.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.upgradeKeeper))
+	app.govKeeper = gov.NewKeeper(
+		app.cdc, keys[gov.StoreKey], govSubspace, app.supplyKeeper, &stakingKeeper, govRouter,
+	)
 
 	// The NFTKeeper is the Keeper from the module NFTs
 	// It handles interactions with the nftstore
-	app.nftKeeper = nft.NewKeeper(app.cdc, keys[nft.StoreKey])
+	app.nftKeeper = nft.NewKeeper(app.cdc, keys[nft.StoreKey], nftSubspace)
+	app.nftKeeper.CoinKeeper = app.bankKeeper
+	app.nftKeeper.SupplyKeeper = app.supplyKeeper
+
+	// register the collectables hooks
+	// NOTE: modules reacting to the lifecycle of NFTs add their hooks here, before the keeper is copied into the module
+	app.nftKeeper = *app.nftKeeper.SetHooks(
+		nft.NewMultiCollectablesHooks(),
+	)
+
+	// register the upgrade of this release and the store loader applying its store upgrades
+	app.registerUpgrade()
 
 	// register the staking hooks
 	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
@@ -178,24 +216,27 @@ func NewCollectablesApp(logger log.Logger, db dbm.DB, traceStore io.Writer, load
 		bank.NewAppModule(app.bankKeeper, app.accountKeeper),
 		crisis.NewAppModule(&app.crisisKeeper),
 		supply.NewAppModule(app.supplyKeeper, app.accountKeeper),
+		gov.NewAppModule(app.govKeeper, app.accountKeeper, app.supplyKeeper),
 		distr.NewAppModule(app.distrKeeper, app.accountKeeper, app.supplyKeeper, app.stakingKeeper),
 		overriddenNFTModule,
 		mint.NewAppModule(app.mintKeeper),
 		slashing.NewAppModule(app.slashingKeeper, app.accountKeeper, app.stakingKeeper),
 		staking.NewAppModule(app.stakingKeeper, app.accountKeeper, app.supplyKeeper),
+		upgrade.NewAppModule(app.upgradeKeeper),
 	)
 
 	// During begin block slashing happens after distr.BeginBlocker so that
 	// there is nothing left over in the validator fee pool, so as to keep the
 	// CanWithdrawInvariant invariant.
-	app.mm.SetOrderBeginBlockers(mint.ModuleName, distr.ModuleName, slashing.ModuleName)
-	app.mm.SetOrderEndBlockers(crisis.ModuleName, staking.ModuleName)
+	app.mm.SetOrderBeginBlockers(upgrade.ModuleName, mint.ModuleName, distr.ModuleName, slashing.ModuleName)
+	app.mm.SetOrderEndBlockers(crisis.ModuleName, gov.ModuleName, staking.ModuleName, nft.ModuleName)
 
 	// NOTE: The genutils module must occur after staking so that pools are
-	// properly initialized with tokens from genesis accounts.
+	// properly initialized with tokens from genesis accounts. Auth must occur first
+	// so that imported accounts keep their numbers ahead of the module accounts.
 	app.mm.SetOrderInitGenesis(
-		distr.ModuleName, staking.ModuleName, auth.ModuleName, bank.ModuleName,
-		slashing.ModuleName, nft.ModuleName, mint.ModuleName, supply.ModuleName,
+		auth.ModuleName, distr.ModuleName, staking.ModuleName, bank.ModuleName,
+		slashing.ModuleName, gov.ModuleName, nft.ModuleName, mint.ModuleName, supply.ModuleName,
 		crisis.ModuleName, genutil.ModuleName,
 	)
 
@@ -206,18 +247,19 @@ func NewCollectablesApp(logger log.Logger, db dbm.DB, traceStore io.Writer, load
 	//
 	// NOTE: This is not required for apps that don't use the simulator for fuzz testing
 	// transactions.
-	// app.sm = module.NewSimulationManager(
-	// 	auth.NewAppModule(app.accountKeeper),
-	// 	bank.NewAppModule(app.bankKeeper, app.accountKeeper),
-	// 	supply.NewAppModule(app.supplyKeeper, app.accountKeeper),
-	// 	nft.NewAppModule(app.nftKeeper, app.accountKeeper),
-	// 	mint.NewAppModule(app.mintKeeper),
-	// 	distr.NewAppModule(app.distrKeeper, app.supplyKeeper),
-	// 	staking.NewAppModule(app.stakingKeeper, app.accountKeeper, app.supplyKeeper),
-	// 	slashing.NewAppModule(app.slashingKeeper, app.stakingKeeper),
-	// )
-
-	// app.sm.RegisterStoreDecoders()
+	app.sm = module.NewSimulationManager(
+		auth.NewAppModule(app.accountKeeper),
+		bank.NewAppModule(app.bankKeeper, app.accountKeeper),
+		supply.NewAppModule(app.supplyKeeper, app.accountKeeper),
+		gov.NewAppModule(app.govKeeper, app.accountKeeper, app.supplyKeeper),
+		nft.NewAppModule(app.nftKeeper, app.accountKeeper),
+		mint.NewAppModule(app.mintKeeper),
+		distr.NewAppModule(app.distrKeeper, app.accountKeeper, app.supplyKeeper, app.stakingKeeper),
+		staking.NewAppModule(app.stakingKeeper, app.accountKeeper, app.supplyKeeper),
+		slashing.NewAppModule(app.slashingKeeper, app.accountKeeper, app.stakingKeeper),
+	)
+
+	app.sm.RegisterStoreDecoders()
 
 	// initialize stores
 	app.MountKVStores(keys)
@@ -241,6 +283,7 @@ func NewCollectablesApp(logger log.Logger, db dbm.DB, traceStore io.Writer, load
 
 // application updates every begin block
 func (app *CollectablesApp) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+	app.applyHaltHeightUpgrade(ctx)
 	return app.mm.BeginBlock(ctx, req)
 }
 
@@ -277,6 +320,11 @@ func (app *CollectablesApp) Codec() *codec.Codec {
 	return app.cdc
 }
 
+// SimulationManager implements the SimulationApp interface
+func (app *CollectablesApp) SimulationManager() *module.SimulationManager {
+	return app.sm
+}
+
 // GetMaccPerms returns a mapping of the application's module account permissions.
 func GetMaccPerms() map[string][]string {
 	modAccPerms := make(map[string][]string)
@@ -0,0 +1,154 @@
+package app
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	bam "github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	distr "github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/mint"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	"github.com/cosmos/cosmos-sdk/x/slashing"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+	nft "github.com/tosch110/collectables/x/collectables"
+)
+
+// The simulations only run with -Enabled=true, e.g.
+// make test-sim-import-export
+func init() {
+	simapp.GetSimulatorFlags()
+}
+
+// fauxMerkleModeOpt returns a BaseApp option to use a dbStoreAdapter instead of
+// an IAVLStore for faster simulation speed.
+func fauxMerkleModeOpt(bapp *bam.BaseApp) {
+	bapp.SetFauxMerkleMode()
+}
+
+// simulate runs the randomized genesis and weighted operations of every module and exports
+// the state and params when requested
+func simulate(t *testing.T, app *CollectablesApp, config simulation.Config) {
+	_, simParams, simErr := simulation.SimulateFromSeed(
+		t, os.Stdout, app.BaseApp, simapp.AppStateFn(app.Codec(), app.SimulationManager()),
+		simapp.SimulationOperations(app, app.Codec(), config),
+		app.ModuleAccountAddrs(), config,
+	)
+
+	// export state and simParams before the simulation error is checked
+	if err := simapp.CheckExportSimulation(app, config, simParams); err != nil {
+		t.Fatal(err)
+	}
+	if simErr != nil {
+		t.Fatal(simErr)
+	}
+}
+
+func TestFullAppSimulation(t *testing.T) {
+	config, db, dir, logger, skip, err := simapp.SetupSimulation("leveldb-app-sim", "Simulation")
+	if skip {
+		t.Skip("skipping application simulation")
+	}
+	if err != nil {
+		t.Fatalf("simulation setup failed: %v", err)
+	}
+	defer func() {
+		db.Close()
+		os.RemoveAll(dir)
+	}()
+
+	app := NewCollectablesApp(logger, db, nil, true, map[int64]bool{}, simapp.FlagPeriodValue, fauxMerkleModeOpt)
+	simulate(t, app, config)
+
+	if config.Commit {
+		simapp.PrintStats(db)
+	}
+}
+
+func TestAppImportExport(t *testing.T) {
+	config, db, dir, logger, skip, err := simapp.SetupSimulation("leveldb-app-sim", "Simulation")
+	if skip {
+		t.Skip("skipping application import/export simulation")
+	}
+	if err != nil {
+		t.Fatalf("simulation setup failed: %v", err)
+	}
+	defer func() {
+		db.Close()
+		os.RemoveAll(dir)
+	}()
+
+	app := NewCollectablesApp(logger, db, nil, true, map[int64]bool{}, simapp.FlagPeriodValue, fauxMerkleModeOpt)
+	simulate(t, app, config)
+
+	fmt.Printf("exporting genesis...\n")
+
+	appState, _, err := app.ExportAppStateAndValidators(false, []string{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fmt.Printf("importing genesis...\n")
+
+	_, newDB, newDir, _, _, err := simapp.SetupSimulation("leveldb-app-sim-2", "Simulation-2")
+	if err != nil {
+		t.Fatalf("simulation setup failed: %v", err)
+	}
+	defer func() {
+		newDB.Close()
+		os.RemoveAll(newDir)
+	}()
+
+	newApp := NewCollectablesApp(log.NewNopLogger(), newDB, nil, true, map[int64]bool{}, simapp.FlagPeriodValue, fauxMerkleModeOpt)
+
+	var genesisState simapp.GenesisState
+	if err := app.Codec().UnmarshalJSON(appState, &genesisState); err != nil {
+		t.Fatal(err)
+	}
+
+	ctxA := app.NewContext(true, abci.Header{Height: app.LastBlockHeight()})
+	ctxB := newApp.NewContext(true, abci.Header{Height: app.LastBlockHeight()})
+	newApp.mm.InitGenesis(ctxB, genesisState)
+
+	fmt.Printf("comparing stores...\n")
+
+	for _, skp := range []struct {
+		key      string
+		prefixes [][]byte
+	}{
+		{bam.MainStoreKey, nil},
+		{auth.StoreKey, nil},
+		{staking.StoreKey, [][]byte{
+			staking.UnbondingQueueKey, staking.RedelegationQueueKey, staking.ValidatorQueueKey,
+		}}, // ordering may change but it doesn't matter
+		{slashing.StoreKey, nil},
+		{mint.StoreKey, nil},
+		{distr.StoreKey, nil},
+		{supply.StoreKey, nil},
+		{gov.StoreKey, nil},
+		{params.StoreKey, nil},
+		{nft.StoreKey, nil},
+	} {
+		storeA := ctxA.KVStore(app.keys[skp.key])
+		storeB := ctxB.KVStore(newApp.keys[skp.key])
+
+		failedKVAs, failedKVBs := sdk.DiffKVStores(storeA, storeB, skp.prefixes)
+		if len(failedKVAs) != len(failedKVBs) {
+			t.Fatalf("unequal sets of key-values to compare in %s", skp.key)
+		}
+
+		fmt.Printf("compared %d key/value pairs between %s stores\n", len(failedKVAs), skp.key)
+		if len(failedKVAs) != 0 {
+			t.Fatal(simapp.GetSimulationLog(skp.key, app.SimulationManager().StoreDecoders, app.Codec(), failedKVAs, failedKVBs))
+		}
+	}
+}
@@ -0,0 +1,295 @@
+package app
+
+import (
+	"errors"
+	"sort"
+	"testing"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/upgrade"
+	nft "github.com/tosch110/collectables/x/collectables"
+	"github.com/tosch110/collectables/x/collectables/keeper"
+	"github.com/tosch110/collectables/x/collectables/types"
+)
+
+const v1Denom = "fighters"
+
+// The v1 types, frozen so that the fixture keeps encoding the store the way v1 did whatever the
+// current types become. v1 registered them under the names of the nft module of the SDK.
+
+type v1NFT interface {
+	GetID() string
+}
+
+type v1BaseNFT struct {
+	ID     string         `json:"id,omitempty" yaml:"id"`
+	Owner  sdk.AccAddress `json:"owner" yaml:"owner"`
+	Hash   string         `json:"hash" yaml:"hash"`
+	Proof  string         `json:"proof" yaml:"proof"`
+	Name   string         `json:"name" yaml:"name"`
+	Wins   uint           `json:"wins" yaml:"wins"`
+	Losses uint           `json:"losses" yaml:"losses"`
+	Price  sdk.Coins      `json:"price" yaml:"price"`
+}
+
+func (n v1BaseNFT) GetID() string { return n.ID }
+
+type v1Collection struct {
+	Denom string  `json:"denom,omitempty" yaml:"denom"`
+	NFTs  []v1NFT `json:"nfts" yaml:"nfts"`
+}
+
+type v1IDCollection struct {
+	Denom string   `json:"denom" yaml:"denom"`
+	IDs   []string `json:"ids" yaml:"ids"`
+}
+
+func v1Codec() *codec.Codec {
+	cdc := codec.New()
+	cdc.RegisterInterface((*v1NFT)(nil), nil)
+	cdc.RegisterConcrete(&v1BaseNFT{}, "cosmos-sdk/BaseNFT", nil)
+	cdc.RegisterConcrete(&v1IDCollection{}, "cosmos-sdk/IDCollection", nil)
+	cdc.RegisterConcrete(&v1Collection{}, "cosmos-sdk/Collection", nil)
+	return cdc
+}
+
+// setupV1Store writes collectables state the way v1 did: the amino encoded collections and the owner
+// records, including the empty ones, without any index nor store version
+func setupV1Store(t *testing.T, app *CollectablesApp, ctx sdk.Context) (owner, former sdk.AccAddress, nfts []v1BaseNFT) {
+	owner = sdk.AccAddress([]byte("v1-owner-address-xxx"))
+	former = sdk.AccAddress([]byte("v1-former-address-xx"))
+	price := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+	for i, proof := range []string{"the first proof of a v1 fighter", "the second proof of a v1 fighter"} {
+		nfts = append(nfts, v1BaseNFT{ID: nft.HashProof(proof)[:16], Owner: owner, Hash: nft.HashProof(proof),
+			Proof: proof, Name: proof, Wins: uint(i), Losses: 1, Price: price})
+	}
+
+	collection := v1Collection{Denom: v1Denom}
+	ids := make([]string, len(nfts))
+	for i := range nfts {
+		collection.NFTs = append(collection.NFTs, &nfts[i])
+		ids[i] = nfts[i].ID
+	}
+	sort.Strings(ids)
+
+	cdc := v1Codec()
+	store := ctx.KVStore(app.keys[nft.StoreKey])
+	store.Set(nft.GetCollectionKey(v1Denom), cdc.MustMarshalBinaryLengthPrefixed(collection))
+	store.Set(nft.GetOwnerKey(owner, v1Denom), cdc.MustMarshalBinaryLengthPrefixed(v1IDCollection{Denom: v1Denom, IDs: ids}))
+	store.Set(nft.GetOwnerKey(former, v1Denom), cdc.MustMarshalBinaryLengthPrefixed(v1IDCollection{Denom: v1Denom, IDs: []string{}}))
+	return owner, former, nfts
+}
+
+func TestCollectablesV2Upgrade(t *testing.T) {
+	app := NewCollectablesApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, 0)
+	ctx := app.BaseApp.NewContext(true, abci.Header{Height: 10})
+	owner, former, nfts := setupV1Store(t, app, ctx)
+	k := app.nftKeeper
+
+	if version := k.GetStoreVersion(ctx); version != 1 {
+		t.Fatalf("expected a v1 store, got v%d", version)
+	}
+	if k.HasHash(ctx, v1Denom, nfts[0].Hash) {
+		t.Fatal("v1 store shouldn't have a hash index")
+	}
+
+	app.upgradeKeeper.ApplyUpgrade(ctx, upgrade.Plan{Name: upgradeName, Height: ctx.BlockHeight()})
+
+	if version := k.GetStoreVersion(ctx); version != nft.ConsensusVersion {
+		t.Fatalf("expected the store to be migrated to v%d, got v%d", nft.ConsensusVersion, version)
+	}
+	if params := k.GetParams(ctx); params != nft.DefaultParams() {
+		t.Fatalf("expected the default params, got %v", params)
+	}
+
+	// the collection is re-encoded with protobuf and keeps its NFTs
+	collection, ok := k.GetCollection(ctx, v1Denom)
+	if !ok || collection.Supply() != len(nfts) {
+		t.Fatalf("expected the collection to keep %d NFTs, got %v", len(nfts), collection)
+	}
+	for _, n := range nfts {
+		expected := nft.NewBaseNFT(n.ID, n.Owner, n.Hash, n.Proof, n.Name, n.Wins, n.Losses, n.Price)
+		if migrated, _ := collection.GetNFT(n.ID); migrated.String() != expected.String() {
+			t.Fatalf("expected NFT\n%s\ngot\n%s", expected.String(), migrated.String())
+		}
+	}
+
+	// the empty owner record is pruned and the owner keeps its NFTs
+	if _, found := k.GetOwnerByDenom(ctx, former, v1Denom); found {
+		t.Fatal("empty owner record wasn't pruned")
+	}
+	if idCollection, _ := k.GetOwnerByDenom(ctx, owner, v1Denom); idCollection.Supply() != len(nfts) {
+		t.Fatalf("expected the owner to keep %d NFTs, got %v", len(nfts), idCollection)
+	}
+
+	// every NFT minted before the upgrade is indexed by its hash and its holder
+	for _, n := range nfts {
+		if !k.HasHash(ctx, v1Denom, n.Hash) {
+			t.Fatalf("hash of NFT #%s isn't indexed", n.ID)
+		}
+		held := false
+		for _, ref := range k.GetHeldBy(ctx, owner) {
+			held = held || ref == nft.NewNFTRef(v1Denom, n.ID)
+		}
+		if !held {
+			t.Fatalf("NFT #%s isn't indexed as held by its owner", n.ID)
+		}
+	}
+
+	// a proof minted before the upgrade can't be minted again
+	duplicate := nft.NewBaseNFT("duplicate", owner, nfts[1].Hash, nfts[1].Proof, "duplicate", 0, 0, sdk.NewCoins())
+	if err := k.MintNFT(ctx, v1Denom, &duplicate); !errors.Is(err, nft.ErrHashAlreadyExists) {
+		t.Fatalf("expected %v, got %v", nft.ErrHashAlreadyExists, err)
+	}
+
+	for _, invariant := range []sdk.Invariant{
+		keeper.OwnershipInvariant(k), keeper.EmptyOwnersInvariant(k), keeper.ProvenanceInvariant(k),
+	} {
+		if msg, broken := invariant(ctx); broken {
+			t.Fatal(msg)
+		}
+	}
+}
+
+func TestMigrateV4ToV5(t *testing.T) {
+	app := NewCollectablesApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, 0)
+	ctx := app.BaseApp.NewContext(true, abci.Header{Height: 10})
+	k := app.nftKeeper
+
+	// the records of v4 have the layout of the current types and were encoded with amino
+	borrower := sdk.AccAddress([]byte("v4-borrower-address-"))
+	lender := sdk.AccAddress([]byte("v4-lender-address-xx"))
+	price := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+	ref := nft.NewNFTRef(v1Denom, "a")
+
+	loan := nft.NewLoan(1, borrower, v1Denom, "a", price, price, 100)
+	loan.Lender, loan.DueHeight = lender, 110
+	vault := nft.NewVault(v1Denom, "b", borrower, sdk.NewInt(1000), price)
+	bundle := nft.NewBundle("1", []nft.NFTRef{ref})
+	swap := nft.NewSwap(1, borrower, lender, []nft.NFTRef{ref}, price, nil, price)
+	entry := nft.HistoryEntry{Denom: v1Denom, ID: "a", Sequence: 1, Height: 5, Action: nft.HistoryActionSale,
+		From: lender, To: borrower, Price: price}
+
+	cdc := MakeCodec()
+	store := ctx.KVStore(app.keys[nft.StoreKey])
+	store.Set(types.GetLoanKey(loan.ID), cdc.MustMarshalBinaryLengthPrefixed(loan))
+	store.Set(types.GetVaultKey(vault.Denom, vault.NFTID), cdc.MustMarshalBinaryLengthPrefixed(vault))
+	store.Set(types.GetBundleKey(bundle.ID), cdc.MustMarshalBinaryLengthPrefixed(bundle))
+	store.Set(types.GetSwapKey(swap.ID), cdc.MustMarshalBinaryLengthPrefixed(swap))
+	store.Set(types.GetHistoryEntryKey(entry.Denom, entry.ID, entry.Sequence), cdc.MustMarshalBinaryLengthPrefixed(entry))
+	store.Set(types.GetHeldKey(lender, ref), cdc.MustMarshalBinaryLengthPrefixed(ref))
+	k.SetStoreVersion(ctx, 4)
+
+	if err := k.RunMigrations(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	// every record decodes from protobuf to the record v4 stored
+	migrated := func(found bool, record, expected interface{}) {
+		if !found || string(cdc.MustMarshalJSON(record)) != string(cdc.MustMarshalJSON(expected)) {
+			t.Errorf("expected %v, got %v", expected, record)
+		}
+	}
+	migratedLoan, found := k.GetLoan(ctx, loan.ID)
+	migrated(found, migratedLoan, loan)
+	migratedVault, found := k.GetVault(ctx, vault.Denom, vault.NFTID)
+	migrated(found, migratedVault, vault)
+	migratedBundle, found := k.GetBundle(ctx, bundle.ID)
+	migrated(found, migratedBundle, bundle)
+	migratedSwap, found := k.GetSwap(ctx, swap.ID)
+	migrated(found, migratedSwap, swap)
+	history := k.GetHistory(ctx, entry.Denom, entry.ID)
+	migrated(len(history) == 1, history, nft.History{entry})
+	migrated(true, k.GetHeldBy(ctx, lender), []nft.NFTRef{ref})
+}
+
+func TestHaltHeightUpgrade(t *testing.T) {
+	app := NewCollectablesApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, 0)
+	ctx := app.BaseApp.NewContext(true, abci.Header{Height: 10})
+	setupV1Store(t, app, ctx)
+
+	// a chain started with v1 had no upgrade module, the upgrade runs at the first block of this release
+	app.applyHaltHeightUpgrade(ctx)
+	if version := app.nftKeeper.GetStoreVersion(ctx); version != nft.ConsensusVersion {
+		t.Fatalf("expected the store to be migrated to v%d, got v%d", nft.ConsensusVersion, version)
+	}
+	if height := app.upgradeKeeper.GetDoneHeight(ctx, upgradeName); height != 10 {
+		t.Fatalf("expected the upgrade to be done at height 10, got %d", height)
+	}
+
+	// and only once
+	app.applyHaltHeightUpgrade(ctx.WithBlockHeight(11))
+	if height := app.upgradeKeeper.GetDoneHeight(ctx, upgradeName); height != 10 {
+		t.Fatalf("expected the upgrade to stay done at height 10, got %d", height)
+	}
+}
+
+func TestSoftwareUpgradeProposal(t *testing.T) {
+	app := NewCollectablesApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, 0)
+	ctx := app.BaseApp.NewContext(true, abci.Header{Height: 10})
+
+	plan := upgrade.Plan{Name: "collectables-v3", Height: 20}
+	proposal := upgrade.SoftwareUpgradeProposal{Title: "v3", Description: "the next release", Plan: plan}
+	if !app.govKeeper.Router().HasRoute(proposal.ProposalRoute()) {
+		t.Fatalf("no gov route for %s proposals", proposal.ProposalRoute())
+	}
+	if err := app.govKeeper.Router().GetRoute(proposal.ProposalRoute())(ctx, proposal); err != nil {
+		t.Fatal(err)
+	}
+	if scheduled, found := app.upgradeKeeper.GetUpgradePlan(ctx); !found || scheduled.Name != plan.Name || scheduled.Height != plan.Height {
+		t.Fatalf("expected %v to be scheduled, got %v", plan, scheduled)
+	}
+}
+
+func TestUpgradeStoreLoader(t *testing.T) {
+	cdc := MakeCodec()
+	keyUpgrade := sdk.NewKVStoreKey(upgrade.StoreKey)
+	keyLegacy := sdk.NewKVStoreKey("legacy")
+	k := upgrade.NewKeeper(map[int64]bool{}, keyUpgrade, cdc)
+
+	// commit the upgrade plan of this release at height 1, with data in a store it deletes
+	commit := func(db dbm.DB, plan upgrade.Plan) {
+		ms := store.NewCommitMultiStore(db)
+		ms.MountStoreWithDB(keyUpgrade, sdk.StoreTypeIAVL, nil)
+		ms.MountStoreWithDB(keyLegacy, sdk.StoreTypeIAVL, nil)
+		if err := ms.LoadLatestVersion(); err != nil {
+			t.Fatal(err)
+		}
+		ctx := sdk.NewContext(ms, abci.Header{Height: 1}, false, log.NewNopLogger())
+		if err := k.ScheduleUpgrade(ctx, plan); err != nil {
+			t.Fatal(err)
+		}
+		ctx.KVStore(keyLegacy).Set([]byte("key"), []byte("value"))
+		ms.Commit()
+	}
+	upgrades := &storetypes.StoreUpgrades{Deleted: []string{keyLegacy.Name()}}
+
+	for _, tc := range []struct {
+		plan    upgrade.Plan
+		deleted bool
+	}{
+		{upgrade.Plan{Name: upgradeName, Height: 2}, true},
+		{upgrade.Plan{Name: upgradeName, Height: 3}, false},
+		{upgrade.Plan{Name: "collectables-v3", Height: 2}, false},
+	} {
+		db := dbm.NewMemDB()
+		commit(db, tc.plan)
+
+		ms := store.NewCommitMultiStore(db)
+		ms.MountStoreWithDB(keyUpgrade, sdk.StoreTypeIAVL, nil)
+		ms.MountStoreWithDB(keyLegacy, sdk.StoreTypeIAVL, nil)
+		if err := upgradeStoreLoader(k, upgrades)(ms); err != nil {
+			t.Fatal(err)
+		}
+		if deleted := !ms.GetKVStore(keyLegacy).Has([]byte("key")); deleted != tc.deleted {
+			t.Errorf("expected the store upgrades of plan %v to be applied: %t, got %t", tc.plan, tc.deleted, deleted)
+		}
+	}
+}
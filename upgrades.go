@@ -0,0 +1,56 @@
+package app
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	bam "github.com/cosmos/cosmos-sdk/baseapp"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/upgrade"
+)
+
+// upgradeName is the software upgrade of this release. It migrates the collectables store in place
+// from v1 up to the consensus version of the module, whatever the number of migrations in between.
+const upgradeName = "collectables-v2"
+
+// storeUpgrades are the stores renamed or deleted by the upgrade of this release. The gov and upgrade
+// stores it adds need no entry: the multistore loads the stores missing from the last commit empty.
+var storeUpgrades = storetypes.StoreUpgrades{}
+
+// registerUpgrade sets the handler of the upgrade of this release and the store loader applying its store upgrades
+func (app *CollectablesApp) registerUpgrade() {
+	app.upgradeKeeper.SetUpgradeHandler(upgradeName, func(ctx sdk.Context, plan upgrade.Plan) {
+		if err := app.nftKeeper.RunMigrations(ctx); err != nil {
+			panic(err)
+		}
+	})
+	app.SetStoreLoader(upgradeStoreLoader(app.upgradeKeeper, &storeUpgrades))
+}
+
+// upgradeStoreLoader returns a store loader applying the store upgrades when the node restarts to run
+// the upgrade of this release, i.e. when its plan is due at the block following the last commit
+func upgradeStoreLoader(k upgrade.Keeper, upgrades *storetypes.StoreUpgrades) bam.StoreLoader {
+	return func(ms sdk.CommitMultiStore) error {
+		if err := ms.LoadLatestVersion(); err != nil {
+			return err
+		}
+
+		ctx := sdk.NewContext(ms.CacheMultiStore(), abci.Header{}, false, log.NewNopLogger())
+		plan, found := k.GetUpgradePlan(ctx)
+		if !found || plan.Name != upgradeName || plan.Height != ms.LastCommitID().Version+1 {
+			return nil
+		}
+		return ms.LoadLatestVersionAndUpgrade(upgrades)
+	}
+}
+
+// applyHaltHeightUpgrade applies the upgrade of this release to the chains started with v1, which had
+// no gov nor upgrade module to schedule it. Their nodes stop at an agreed halt height and restart with
+// this release, which runs the upgrade at its first block.
+func (app *CollectablesApp) applyHaltHeightUpgrade(ctx sdk.Context) {
+	if app.nftKeeper.GetStoreVersion(ctx) != 1 || app.upgradeKeeper.GetDoneHeight(ctx, upgradeName) != 0 {
+		return
+	}
+	app.upgradeKeeper.ApplyUpgrade(ctx, upgrade.Plan{Name: upgradeName, Height: ctx.BlockHeight()})
+}
@@ -6,97 +6,372 @@ import (
 )
 
 const (
-	QuerySupply       = keeper.QuerySupply
-	QueryOwner        = keeper.QueryOwner
-	QueryOwnerByDenom = keeper.QueryOwnerByDenom
-	QueryCollection   = keeper.QueryCollection
-	QueryDenoms       = keeper.QueryDenoms
-	QueryNFT          = keeper.QueryNFT
-	ModuleName        = types.ModuleName
-	StoreKey          = types.StoreKey
-	QuerierRoute      = types.QuerierRoute
-	RouterKey         = types.RouterKey
+	QuerySupply                = keeper.QuerySupply
+	QueryOwner                 = keeper.QueryOwner
+	QueryOwnerByDenom          = keeper.QueryOwnerByDenom
+	QueryCollection            = keeper.QueryCollection
+	QueryDenoms                = keeper.QueryDenoms
+	QueryNFT                   = keeper.QueryNFT
+	QueryByHash                = keeper.QueryByHash
+	QuerySearch                = keeper.QuerySearch
+	QueryRarity                = keeper.QueryRarity
+	QueryRarityRank            = keeper.QueryRarityRank
+	QueryReveal                = keeper.QueryReveal
+	QueryLoan                  = keeper.QueryLoan
+	QueryLoans                 = keeper.QueryLoans
+	QueryVault                 = keeper.QueryVault
+	QueryVaults                = keeper.QueryVaults
+	ModuleName                 = types.ModuleName
+	StoreKey                   = types.StoreKey
+	QuerierRoute               = types.QuerierRoute
+	RouterKey                  = types.RouterKey
+	ConsensusVersion           = types.ConsensusVersion
+	ShareDenomPrefix           = types.ShareDenomPrefix
+	MaxLoanDuration            = types.MaxLoanDuration
+	BundleDenom                = types.BundleDenom
+	MaxBundleSize              = types.MaxBundleSize
+	QueryBundle                = keeper.QueryBundle
+	QuerySwap                  = keeper.QuerySwap
+	QuerySwaps                 = keeper.QuerySwaps
+	MaxSwapSize                = types.MaxSwapSize
+	QueryEquipment             = keeper.QueryEquipment
+	ItemDenom                  = types.ItemDenom
+	FighterDenom               = types.FighterDenom
+	MaxEquipped                = types.MaxEquipped
+	TransferPolicyTransferable = types.TransferPolicyTransferable
+	TransferPolicyBurnOnly     = types.TransferPolicyBurnOnly
+	TransferPolicySoulbound    = types.TransferPolicySoulbound
+	IssuerCapabilityFreeze     = types.IssuerCapabilityFreeze
+	IssuerCapabilityClawback   = types.IssuerCapabilityClawback
+	QueryHistory               = keeper.QueryHistory
+	QueryCreatedBy             = keeper.QueryCreatedBy
+	QueryHeldBy                = keeper.QueryHeldBy
+	DefaultQueryLimit          = types.DefaultQueryLimit
+	DefaultParamspace          = types.DefaultParamspace
+	DefaultHistoryLimit        = types.DefaultHistoryLimit
+	MaxHistoryLimit            = types.MaxHistoryLimit
+	HistoryActionMint          = types.HistoryActionMint
+	HistoryActionTransfer      = types.HistoryActionTransfer
+	HistoryActionSale          = types.HistoryActionSale
+	HistoryActionBurn          = types.HistoryActionBurn
+	HistoryActionChallenge     = types.HistoryActionChallenge
+	HistoryActionEditMetadata  = types.HistoryActionEditMetadata
+	ChallengeOutcomeWon        = types.ChallengeOutcomeWon
+	ChallengeOutcomeLost       = types.ChallengeOutcomeLost
 )
 
 var (
 	// functions aliases
-	RegisterInvariants       = keeper.RegisterInvariants
-	AllInvariants            = keeper.AllInvariants
-	SupplyInvariant          = keeper.SupplyInvariant
-	NewKeeper                = keeper.NewKeeper
-	NewQuerier               = keeper.NewQuerier
-	RegisterCodec            = types.RegisterCodec
-	NewCollection            = types.NewCollection
-	EmptyCollection          = types.EmptyCollection
-	NewCollections           = types.NewCollections
-	ErrInvalidCollection     = types.ErrInvalidCollection
-	ErrUnknownCollection     = types.ErrUnknownCollection
-	ErrInvalidNFT            = types.ErrInvalidNFT
-	ErrNFTAlreadyExists      = types.ErrNFTAlreadyExists
-	ErrUnknownNFT            = types.ErrUnknownNFT
-	ErrEmptyMetadata         = types.ErrEmptyMetadata
-	NewGenesisState          = types.NewGenesisState
-	DefaultGenesisState      = types.DefaultGenesisState
-	ValidateGenesis          = types.ValidateGenesis
-	GetCollectionKey         = types.GetCollectionKey
-	SplitOwnerKey            = types.SplitOwnerKey
-	GetOwnersKey             = types.GetOwnersKey
-	GetOwnerKey              = types.GetOwnerKey
-	NewMsgSendNFT            = types.NewMsgSendNFT
-	NewMsgEditNFTMetadata    = types.NewMsgEditNFTMetadata
-	NewMsgEditNFTPrice       = types.NewMsgEditNFTPrice
-	NewMsgMintNFT            = types.NewMsgMintNFT
-	NewMsgBurnNFT            = types.NewMsgBurnNFT
-	NewMsgBuyNFT             = types.NewMsgBuyNFT
-	NewMsgChallengeNFT       = types.NewMsgChallengeNFT
-	NewBaseNFT               = types.NewBaseNFT
-	NewNFTs                  = types.NewNFTs
-	NewIDCollection          = types.NewIDCollection
-	NewOwner                 = types.NewOwner
-	NewQueryCollectionParams = types.NewQueryCollectionParams
-	NewQueryBalanceParams    = types.NewQueryBalanceParams
-	NewQueryNFTParams        = types.NewQueryNFTParams
+	RegisterInvariants        = keeper.RegisterInvariants
+	AllInvariants             = keeper.AllInvariants
+	SupplyInvariant           = keeper.SupplyInvariant
+	EscrowInvariant           = keeper.EscrowInvariant
+	NewKeeper                 = keeper.NewKeeper
+	NewQuerier                = keeper.NewQuerier
+	WrapSDKContext            = keeper.WrapSDKContext
+	RegisterCodec             = types.RegisterCodec
+	NewCollection             = types.NewCollection
+	NFTToProto                = types.NFTToProto
+	NFTFromProto              = types.NFTFromProto
+	CollectionFromProto       = types.CollectionFromProto
+	MustMarshalCollection     = types.MustMarshalCollection
+	MustUnmarshalCollection   = types.MustUnmarshalCollection
+	EmptyCollection           = types.EmptyCollection
+	NewCreatedCollection      = types.NewCreatedCollection
+	NewCollections            = types.NewCollections
+	ErrInvalidCollection      = types.ErrInvalidCollection
+	ErrUnknownCollection      = types.ErrUnknownCollection
+	ErrInvalidNFT             = types.ErrInvalidNFT
+	ErrNFTAlreadyExists       = types.ErrNFTAlreadyExists
+	ErrUnknownNFT             = types.ErrUnknownNFT
+	ErrEmptyMetadata          = types.ErrEmptyMetadata
+	ErrEmptyProof             = types.ErrEmptyProof
+	ErrInvalidProof           = types.ErrInvalidProof
+	ErrInvalidHash            = types.ErrInvalidHash
+	ErrHashMismatch           = types.ErrHashMismatch
+	ErrHashAlreadyExists      = types.ErrHashAlreadyExists
+	ErrInvalidTrait           = types.ErrInvalidTrait
+	ErrTraitNotInSchema       = types.ErrTraitNotInSchema
+	ErrCollectionExists       = types.ErrCollectionExists
+	ErrMetadataFrozen         = types.ErrMetadataFrozen
+	ErrImmutableField         = types.ErrImmutableField
+	ErrInvalidCommitment      = types.ErrInvalidCommitment
+	ErrRevealMismatch         = types.ErrRevealMismatch
+	ErrAlreadyRevealed        = types.ErrAlreadyRevealed
+	ErrNotRevealed            = types.ErrNotRevealed
+	ErrNonTransferable        = types.ErrNonTransferable
+	ErrInvalidTransferPolicy  = types.ErrInvalidTransferPolicy
+	ErrNotBurnable            = types.ErrNotBurnable
+	ErrIssuerFrozen           = types.ErrIssuerFrozen
+	ErrInvalidCapability      = types.ErrInvalidCapability
+	ErrMissingCapability      = types.ErrMissingCapability
+	ErrRented                 = types.ErrRented
+	ErrInvalidRental          = types.ErrInvalidRental
+	ErrEscrowed               = types.ErrEscrowed
+	ErrInvalidLoan            = types.ErrInvalidLoan
+	ErrUnknownLoan            = types.ErrUnknownLoan
+	ErrInvalidVault           = types.ErrInvalidVault
+	ErrUnknownVault           = types.ErrUnknownVault
+	ErrInsufficientShares     = types.ErrInsufficientShares
+	ErrInvalidBundle          = types.ErrInvalidBundle
+	ErrUnknownBundle          = types.ErrUnknownBundle
+	ErrInvalidSwap            = types.ErrInvalidSwap
+	ErrUnknownSwap            = types.ErrUnknownSwap
+	ErrInvalidEquipment       = types.ErrInvalidEquipment
+	ErrEquipmentCycle         = types.ErrEquipmentCycle
+	ErrUnknownMigration       = types.ErrUnknownMigration
+	ErrInvalidEvent           = types.ErrInvalidEvent
+	ErrInvalidHistory         = types.ErrInvalidHistory
+	ValidateProof             = types.ValidateProof
+	ValidateHash              = types.ValidateHash
+	ValidateProofHash         = types.ValidateProofHash
+	HashProof                 = types.HashProof
+	NewGenesisState           = types.NewGenesisState
+	DefaultGenesisState       = types.DefaultGenesisState
+	ValidateGenesis           = types.ValidateGenesis
+	GetCollectionKey          = types.GetCollectionKey
+	SplitOwnerKey             = types.SplitOwnerKey
+	GetOwnersKey              = types.GetOwnersKey
+	GetOwnerKey               = types.GetOwnerKey
+	GetHashesKey              = types.GetHashesKey
+	GetHashKey                = types.GetHashKey
+	NewMsgSendNFT             = types.NewMsgSendNFT
+	NewMsgEditNFTMetadata     = types.NewMsgEditNFTMetadata
+	NewMsgEditNFTPrice        = types.NewMsgEditNFTPrice
+	NewMsgMintNFT             = types.NewMsgMintNFT
+	NewMsgBurnNFT             = types.NewMsgBurnNFT
+	NewMsgBuyNFT              = types.NewMsgBuyNFT
+	NewMsgChallengeNFT        = types.NewMsgChallengeNFT
+	NewMsgCreateCollection    = types.NewMsgCreateCollection
+	NewMsgFreezeMetadata      = types.NewMsgFreezeMetadata
+	NewMsgRevealCollection    = types.NewMsgRevealCollection
+	NewMsgFreezeNFT           = types.NewMsgFreezeNFT
+	NewMsgUnfreezeNFT         = types.NewMsgUnfreezeNFT
+	NewMsgClawbackNFT         = types.NewMsgClawbackNFT
+	NewMsgRentNFT             = types.NewMsgRentNFT
+	NewMsgRequestLoan         = types.NewMsgRequestLoan
+	NewMsgCancelLoan          = types.NewMsgCancelLoan
+	NewMsgFundLoan            = types.NewMsgFundLoan
+	NewMsgRepayLoan           = types.NewMsgRepayLoan
+	NewLoan                   = types.NewLoan
+	NewMsgFractionalize       = types.NewMsgFractionalize
+	NewMsgRedeem              = types.NewMsgRedeem
+	NewMsgBuyout              = types.NewMsgBuyout
+	NewVault                  = types.NewVault
+	GetShareDenom             = types.GetShareDenom
+	NewMsgWrapNFTs            = types.NewMsgWrapNFTs
+	NewMsgUnwrapBundle        = types.NewMsgUnwrapBundle
+	NewBundle                 = types.NewBundle
+	BundleProof               = types.BundleProof
+	ValidateBundleComponents  = types.ValidateBundleComponents
+	ParseNFTRef               = types.ParseNFTRef
+	NewQueryBundleParams      = types.NewQueryBundleParams
+	NewMsgProposeSwap         = types.NewMsgProposeSwap
+	NewMsgAcceptSwap          = types.NewMsgAcceptSwap
+	NewMsgCancelSwap          = types.NewMsgCancelSwap
+	NewSwap                   = types.NewSwap
+	NewMultiCollectablesHooks = types.NewMultiCollectablesHooks
+	NewQuerySwapParams        = types.NewQuerySwapParams
+	NewQuerySwapsParams       = types.NewQuerySwapsParams
+	NewMsgEquip               = types.NewMsgEquip
+	NewMsgUnequip             = types.NewMsgUnequip
+	NewEquipment              = types.NewEquipment
+	ValidateEquip             = types.ValidateEquip
+	IsEquipped                = types.IsEquipped
+	GetEquipmentKey           = types.GetEquipmentKey
+	GetEquippedKey            = types.GetEquippedKey
+	NewBaseNFT                = types.NewBaseNFT
+	NewNFTs                   = types.NewNFTs
+	NewIDCollection           = types.NewIDCollection
+	NewOwner                  = types.NewOwner
+	DeriveOwners              = types.DeriveOwners
+	NewHolder                 = types.NewHolder
+	DeriveHolders             = types.DeriveHolders
+	NewNFTRef                 = types.NewNFTRef
+	NewTrait                  = types.NewTrait
+	NewTraits                 = types.NewTraits
+	NewStats                  = types.NewStats
+	NewRarity                 = types.NewRarity
+	NewNFTMetadata            = types.NewNFTMetadata
+	NewRevealStatus           = types.NewRevealStatus
+	ValidateCommitment        = types.ValidateCommitment
+	ParseTransferPolicy       = types.ParseTransferPolicy
+	ParseIssuerCapability     = types.ParseIssuerCapability
+	ParseEventMintNFT         = types.ParseEventMintNFT
+	ParseEventTransferNFT     = types.ParseEventTransferNFT
+	ParseEventBuyNFT          = types.ParseEventBuyNFT
+	ParseEventBurnNFT         = types.ParseEventBurnNFT
+	ParseEventChallengeNFT    = types.ParseEventChallengeNFT
+	ParseEventEditNFTPrice    = types.ParseEventEditNFTPrice
+	NewParams                 = types.NewParams
+	DefaultParams             = types.DefaultParams
+	ParamKeyTable             = types.ParamKeyTable
+	NewMintEntry              = types.NewMintEntry
+	NewTransferEntry          = types.NewTransferEntry
+	NewSaleEntry              = types.NewSaleEntry
+	NewBurnEntry              = types.NewBurnEntry
+	NewChallengeEntry         = types.NewChallengeEntry
+	NewEditMetadataEntry      = types.NewEditMetadataEntry
+	NewQueryHistoryParams     = types.NewQueryHistoryParams
+	NewQueryAddressParams     = types.NewQueryAddressParams
+	CheckTransferable         = types.CheckTransferable
+	CheckBurnable             = types.CheckBurnable
+	CheckUsable               = types.CheckUsable
+	IsRented                  = types.IsRented
+	CanUse                    = types.CanUse
+	RarityTraits              = types.RarityTraits
+	ParseTrait                = types.ParseTrait
+	ParseTraitDefinition      = types.ParseTraitDefinition
+	NewQueryCollectionParams  = types.NewQueryCollectionParams
+	NewQueryBalanceParams     = types.NewQueryBalanceParams
+	NewQueryNFTParams         = types.NewQueryNFTParams
+	NewQueryHashParams        = types.NewQueryHashParams
+	NewQuerySearchParams      = types.NewQuerySearchParams
+	NewQueryLoanParams        = types.NewQueryLoanParams
+	NewQueryLoansParams       = types.NewQueryLoansParams
+	NewQueryVaultsParams      = types.NewQueryVaultsParams
 
 	// variable aliases
-	ModuleCdc                = types.ModuleCdc
-	EventTypeSend            = types.EventTypeSend
-	EventTypeEditNFTMetadata = types.EventTypeEditNFTMetadata
-	EventTypeMintNFT         = types.EventTypeMintNFT
-	EventTypeBurnNFT         = types.EventTypeBurnNFT
-	AttributeValueCategory   = types.AttributeValueCategory
-	AttributeKeySender       = types.AttributeKeySender
-	AttributeKeyRecipient    = types.AttributeKeyRecipient
-	AttributeKeyOwner        = types.AttributeKeyOwner
-	AttributeKeyNFTID        = types.AttributeKeyNFTID
-	AttributeKeyNFTName      = types.AttributeKeyNFTName
-	AttributeKeyNFTHash      = types.AttributeKeyNFTHash
-	AttributeKeyNFTProof     = types.AttributeKeyNFTProof
-	AttributeKeyNFTPrice     = types.AttributeKeyNFTPrice
-	AttributeKeyDenom        = types.AttributeKeyDenom
-	CollectionsKeyPrefix     = types.CollectionsKeyPrefix
-	OwnersKeyPrefix          = types.OwnersKeyPrefix
+	ModuleCdc                 = types.ModuleCdc
+	EscrowAddress             = types.EscrowAddress
+	EventTypeSend             = types.EventTypeSend
+	EventTypeEditNFTMetadata  = types.EventTypeEditNFTMetadata
+	EventTypeMintNFT          = types.EventTypeMintNFT
+	EventTypeBuyNFT           = types.EventTypeBuyNFT
+	EventTypeEditNFTPrice     = types.EventTypeEditNFTPrice
+	EventTypeBurnNFT          = types.EventTypeBurnNFT
+	EventTypeChallengeNFT     = types.EventTypeChallengeNFT
+	EventTypeCreateCollection = types.EventTypeCreateCollection
+	EventTypeFreezeMetadata   = types.EventTypeFreezeMetadata
+	EventTypeRevealCollection = types.EventTypeRevealCollection
+	EventTypeFreezeNFT        = types.EventTypeFreezeNFT
+	EventTypeUnfreezeNFT      = types.EventTypeUnfreezeNFT
+	EventTypeClawbackNFT      = types.EventTypeClawbackNFT
+	EventTypeRentNFT          = types.EventTypeRentNFT
+	EventTypeEndRental        = types.EventTypeEndRental
+	EventTypeRequestLoan      = types.EventTypeRequestLoan
+	EventTypeCancelLoan       = types.EventTypeCancelLoan
+	EventTypeFundLoan         = types.EventTypeFundLoan
+	EventTypeRepayLoan        = types.EventTypeRepayLoan
+	EventTypeLiquidateLoan    = types.EventTypeLiquidateLoan
+	EventTypeFractionalize    = types.EventTypeFractionalize
+	EventTypeRedeem           = types.EventTypeRedeem
+	EventTypeBuyout           = types.EventTypeBuyout
+	EventTypeWrapNFTs         = types.EventTypeWrapNFTs
+	EventTypeUnwrapBundle     = types.EventTypeUnwrapBundle
+	EventTypeProposeSwap      = types.EventTypeProposeSwap
+	EventTypeAcceptSwap       = types.EventTypeAcceptSwap
+	EventTypeCancelSwap       = types.EventTypeCancelSwap
+	EventTypeEquip            = types.EventTypeEquip
+	EventTypeUnequip          = types.EventTypeUnequip
+	AttributeValueCategory    = types.AttributeValueCategory
+	AttributeKeySender        = types.AttributeKeySender
+	AttributeKeyRecipient     = types.AttributeKeyRecipient
+	AttributeKeyOwner         = types.AttributeKeyOwner
+	AttributeKeyNFTID         = types.AttributeKeyNFTID
+	AttributeKeyNFTName       = types.AttributeKeyNFTName
+	AttributeKeyNFTHash       = types.AttributeKeyNFTHash
+	AttributeKeyNFTProof      = types.AttributeKeyNFTProof
+	AttributeKeyNFTPrice      = types.AttributeKeyNFTPrice
+	AttributeKeyDenom         = types.AttributeKeyDenom
+	CollectionsKeyPrefix      = types.CollectionsKeyPrefix
+	OwnersKeyPrefix           = types.OwnersKeyPrefix
+	HashesKeyPrefix           = types.HashesKeyPrefix
+	TraitCountsKeyPrefix      = types.TraitCountsKeyPrefix
+	TraitsKeyPrefix           = types.TraitsKeyPrefix
 )
 
 type (
-	Keeper                = keeper.Keeper
-	Collection            = types.Collection
-	Collections           = types.Collections
-	CollectionJSON        = types.CollectionJSON
-	GenesisState          = types.GenesisState
-	MsgSendNFT            = types.MsgSendNFT
-	MsgEditNFTMetadata    = types.MsgEditNFTMetadata
-	MsgEditNFTPrice       = types.MsgEditNFTPrice
-	MsgMintNFT            = types.MsgMintNFT
-	MsgBurnNFT            = types.MsgBurnNFT
-	MsgBuyNFT             = types.MsgBuyNFT
-	MsgChallengeNFT       = types.MsgChallengeNFT
-	BaseNFT               = types.BaseNFT
-	NFTs                  = types.NFTs
-	NFTJSON               = types.NFTJSON
-	IDCollection          = types.IDCollection
-	IDCollections         = types.IDCollections
-	Owner                 = types.Owner
-	QueryCollectionParams = types.QueryCollectionParams
-	QueryBalanceParams    = types.QueryBalanceParams
-	QueryNFTParams        = types.QueryNFTParams
+	Keeper                 = keeper.Keeper
+	Collection             = types.Collection
+	Collections            = types.Collections
+	CollectionJSON         = types.CollectionJSON
+	GenesisState           = types.GenesisState
+	MsgSendNFT             = types.MsgSendNFT
+	MsgEditNFTMetadata     = types.MsgEditNFTMetadata
+	MsgEditNFTPrice        = types.MsgEditNFTPrice
+	MsgMintNFT             = types.MsgMintNFT
+	MsgBurnNFT             = types.MsgBurnNFT
+	MsgBuyNFT              = types.MsgBuyNFT
+	MsgChallengeNFT        = types.MsgChallengeNFT
+	MsgCreateCollection    = types.MsgCreateCollection
+	MsgFreezeMetadata      = types.MsgFreezeMetadata
+	MsgRevealCollection    = types.MsgRevealCollection
+	MsgFreezeNFT           = types.MsgFreezeNFT
+	MsgUnfreezeNFT         = types.MsgUnfreezeNFT
+	MsgClawbackNFT         = types.MsgClawbackNFT
+	MsgRentNFT             = types.MsgRentNFT
+	MsgRequestLoan         = types.MsgRequestLoan
+	MsgCancelLoan          = types.MsgCancelLoan
+	MsgFundLoan            = types.MsgFundLoan
+	MsgRepayLoan           = types.MsgRepayLoan
+	Loan                   = types.Loan
+	Loans                  = types.Loans
+	QueryLoanParams        = types.QueryLoanParams
+	QueryLoansParams       = types.QueryLoansParams
+	MsgFractionalize       = types.MsgFractionalize
+	MsgRedeem              = types.MsgRedeem
+	MsgBuyout              = types.MsgBuyout
+	Vault                  = types.Vault
+	Vaults                 = types.Vaults
+	QueryVaultsParams      = types.QueryVaultsParams
+	MsgWrapNFTs            = types.MsgWrapNFTs
+	MsgUnwrapBundle        = types.MsgUnwrapBundle
+	Bundle                 = types.Bundle
+	Bundles                = types.Bundles
+	QueryBundleParams      = types.QueryBundleParams
+	MsgProposeSwap         = types.MsgProposeSwap
+	MsgAcceptSwap          = types.MsgAcceptSwap
+	MsgCancelSwap          = types.MsgCancelSwap
+	Swap                   = types.Swap
+	Swaps                  = types.Swaps
+	CollectablesHooks      = types.CollectablesHooks
+	MultiCollectablesHooks = types.MultiCollectablesHooks
+	NFTRefs                = types.NFTRefs
+	QuerySwapParams        = types.QuerySwapParams
+	QuerySwapsParams       = types.QuerySwapsParams
+	MsgEquip               = types.MsgEquip
+	MsgUnequip             = types.MsgUnequip
+	Equipment              = types.Equipment
+	BaseNFT                = types.BaseNFT
+	NFTs                   = types.NFTs
+	NFTJSON                = types.NFTJSON
+	IDCollection           = types.IDCollection
+	IDCollections          = types.IDCollections
+	Owner                  = types.Owner
+	NFTRef                 = types.NFTRef
+	Trait                  = types.Trait
+	Traits                 = types.Traits
+	TraitDefinition        = types.TraitDefinition
+	TraitSchema            = types.TraitSchema
+	Stats                  = types.Stats
+	Rarity                 = types.Rarity
+	Rarities               = types.Rarities
+	NFTMetadata            = types.NFTMetadata
+	RevealMetadata         = types.RevealMetadata
+	RevealStatus           = types.RevealStatus
+	TransferPolicy         = types.TransferPolicy
+	IssuerCapability       = types.IssuerCapability
+	IssuerCapabilities     = types.IssuerCapabilities
+	EventMintNFT           = types.EventMintNFT
+	EventTransferNFT       = types.EventTransferNFT
+	EventBuyNFT            = types.EventBuyNFT
+	EventBurnNFT           = types.EventBurnNFT
+	EventChallengeNFT      = types.EventChallengeNFT
+	EventEditNFTPrice      = types.EventEditNFTPrice
+	Params                 = types.Params
+	HistoryAction          = types.HistoryAction
+	HistoryEntry           = types.HistoryEntry
+	History                = types.History
+	QueryHistoryParams     = types.QueryHistoryParams
+	Holder                 = types.Holder
+	QueryAddressParams     = types.QueryAddressParams
+	TraitGenerator         = keeper.TraitGenerator
+	TraitGeneratorV1       = keeper.TraitGeneratorV1
+	Migration              = keeper.Migration
+	QueryCollectionParams  = types.QueryCollectionParams
+	QueryBalanceParams     = types.QueryBalanceParams
+	QueryNFTParams         = types.QueryNFTParams
+	QueryHashParams        = types.QueryHashParams
+	QuerySearchParams      = types.QuerySearchParams
 )
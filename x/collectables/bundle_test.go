@@ -0,0 +1,73 @@
+package collectables_test
+
+import (
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	nft "github.com/tosch110/collectables/x/collectables"
+)
+
+func TestBundleUnwrapToOwner(t *testing.T) {
+	ctx, k, _ := createTestInput(t)
+	nft.InitGenesis(ctx, k, nft.DefaultGenesisState())
+	handler := nft.GenericHandler(k)
+	mint(t, ctx, k, nft.FighterDenom, "a", alice, nil)
+	mint(t, ctx, k, nft.ItemDenom, "sword", alice, nil)
+	components := []nft.NFTRef{nft.NewNFTRef(nft.FighterDenom, "a"), nft.NewNFTRef(nft.ItemDenom, "sword")}
+
+	res, err := handler(ctx, nft.NewMsgWrapNFTs(alice, components))
+	if err != nil {
+		t.Fatal(err)
+	}
+	id := string(res.Data)
+	for _, ref := range components {
+		checkOwner(t, ctx, k, ref.Denom, ref.ID, nft.EscrowAddress)
+	}
+	checkOwner(t, ctx, k, nft.BundleDenom, id, alice)
+	checkEscrow(t, ctx, k)
+
+	// the bundle changes hands as a whole
+	if _, err := handler(ctx, nft.NewMsgSendNFT(alice, bob, nft.BundleDenom, id)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := handler(ctx, nft.NewMsgUnwrapBundle(alice, id)); !errors.Is(err, sdkerrors.ErrUnauthorized) {
+		t.Fatalf("expected %v, got %v", sdkerrors.ErrUnauthorized, err)
+	}
+
+	// and every component goes to the owner of the bundle, not to the one that wrapped it
+	if _, err := handler(ctx, nft.NewMsgUnwrapBundle(bob, id)); err != nil {
+		t.Fatal(err)
+	}
+	for _, ref := range components {
+		checkOwner(t, ctx, k, ref.Denom, ref.ID, bob)
+	}
+	if _, err := k.GetNFT(ctx, nft.BundleDenom, id); !errors.Is(err, nft.ErrUnknownNFT) {
+		t.Fatalf("expected the bundle NFT to be burned, got %v", err)
+	}
+	if _, found := k.GetBundle(ctx, id); found {
+		t.Fatal("expected the unwrapped bundle to be removed")
+	}
+	checkEscrow(t, ctx, k)
+}
+
+func TestBundleMintRejected(t *testing.T) {
+	ctx, k, _ := createTestInput(t)
+	nft.InitGenesis(ctx, k, nft.DefaultGenesisState())
+	handler := nft.GenericHandler(k)
+
+	// even with the proof the module gives the NFT of the next bundle
+	proof := nft.BundleProof("1")
+	msg := nft.NewMsgMintNFT(alice, alice, "1", nft.BundleDenom, nft.HashProof(proof), proof, "1", sdk.NewCoins(), "")
+	if err := msg.ValidateBasic(); !errors.Is(err, nft.ErrInvalidCollection) {
+		t.Fatalf("expected %v, got %v", nft.ErrInvalidCollection, err)
+	}
+	if _, err := handler(ctx, msg); !errors.Is(err, nft.ErrInvalidCollection) {
+		t.Fatalf("expected %v, got %v", nft.ErrInvalidCollection, err)
+	}
+	if _, found := k.GetCollection(ctx, nft.BundleDenom); found {
+		t.Fatal("expected no bundle NFT to be minted")
+	}
+}
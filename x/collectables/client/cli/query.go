@@ -2,9 +2,11 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
@@ -29,6 +31,22 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		GetCmdQueryCollection(queryRoute, cdc),
 		GetCmdQueryDenoms(queryRoute, cdc),
 		GetCmdQueryNFT(queryRoute, cdc),
+		GetCmdQueryByHash(queryRoute, cdc),
+		GetCmdQuerySearch(queryRoute, cdc),
+		GetCmdQueryRarity(queryRoute, cdc),
+		GetCmdQueryRarityRank(queryRoute, cdc),
+		GetCmdQueryReveal(queryRoute, cdc),
+		GetCmdQueryLoan(queryRoute, cdc),
+		GetCmdQueryLoans(queryRoute, cdc),
+		GetCmdQueryVault(queryRoute, cdc),
+		GetCmdQueryVaults(queryRoute, cdc),
+		GetCmdQueryBundle(queryRoute, cdc),
+		GetCmdQuerySwap(queryRoute, cdc),
+		GetCmdQuerySwaps(queryRoute, cdc),
+		GetCmdQueryEquipment(queryRoute, cdc),
+		GetCmdQueryHistory(queryRoute, cdc),
+		GetCmdQueryCreatedBy(queryRoute, cdc),
+		GetCmdQueryHeldBy(queryRoute, cdc),
 	)...)
 
 	return nftQueryCmd
@@ -238,3 +256,701 @@ $ %s query %s token collectables d04b98f48e8f8bcc15c6ae5ac050801cd6dcfd428fb5f9e
 		},
 	}
 }
+
+// GetCmdQueryByHash queries the NFTs that were minted with a given hash
+func GetCmdQueryByHash(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "by-hash [hash]",
+		Short: "query the NFTs minted with a blake3 hash",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Get the denom and ID of every NFT that was minted with the given blake3 hash.
+Example:
+$ %s query %s by-hash d04b98f48e8f8bcc15c6ae5ac050801cd6dcfd428fb5f9e65c4e16e7807340fa
+`, version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			hash := args[0]
+
+			params := types.NewQueryHashParams(hash)
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/byHash", queryRoute), bz)
+			if err != nil {
+				return err
+			}
+
+			var out []types.NFTRef
+			err = cdc.UnmarshalJSON(res, &out)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdQuerySearch queries the NFTs that have the given traits
+func GetCmdQuerySearch(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "search",
+		Short: "search NFTs by their traits",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Get the NFTs that have all the given traits, optionally within a single collection.
+Example:
+$ %s query %s search --trait color=red
+$ %s query %s search --trait color=red --trait level=3 --denom fighters
+`, version.ClientName, types.ModuleName, version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			pairs, err := cmd.Flags().GetStringArray(flagTrait)
+			if err != nil {
+				return err
+			}
+			traits, err := parseTraits(pairs)
+			if err != nil {
+				return err
+			}
+
+			params := types.NewQuerySearchParams(viper.GetString(flagDenom), traits)
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/search", queryRoute), bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.Collections
+			err = cdc.UnmarshalJSON(res, &out)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(out)
+		},
+	}
+
+	cmd.Flags().StringArray(flagTrait, []string{}, "Trait the NFTs must have as key=value")
+	cmd.Flags().String(flagDenom, "", "Only search the collection of this denom")
+	return cmd
+}
+
+// GetCmdQueryRarity queries the rarity score and rank of a single NFT
+func GetCmdQueryRarity(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "rarity [denom] [ID]",
+		Short: "query the rarity score and rank of an NFT within its collection",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Get the rarity score of an NFT, the sum of supply/frequency of each of its traits,
+and its rank within the collection (1 is the rarest).
+Example:
+$ %s query %s rarity collectables d04b98f48e8f8bcc15c6ae5ac050801cd6dcfd428fb5f9e65c4e16e7807340fa
+`, version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			params := types.NewQueryNFTParams(args[0], args[1])
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/rarity", queryRoute), bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.Rarity
+			err = cdc.UnmarshalJSON(res, &out)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdQueryRarityRank queries the NFTs of a collection ranked by rarity
+func GetCmdQueryRarityRank(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "rarity-rank [denom]",
+		Short: "list the NFTs of a collection from the rarest to the most common",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Get the rarity score and rank of every NFT of a collection.
+Example:
+$ %s query %s rarity-rank collectables
+`, version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			params := types.NewQueryCollectionParams(args[0])
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/rarityRank", queryRoute), bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.Rarities
+			err = cdc.UnmarshalJSON(res, &out)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdQueryReveal queries the reveal status of a collection
+func GetCmdQueryReveal(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "reveal [denom]",
+		Short: "query whether the metadata of a collection has been revealed",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Get the reveal commitment of a collection and whether its final metadata has been published.
+Example:
+$ %s query %s reveal fighters
+`, version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			params := types.NewQueryCollectionParams(args[0])
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/reveal", queryRoute), bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.RevealStatus
+			err = cdc.UnmarshalJSON(res, &out)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdQueryLoan queries a loan by its ID
+func GetCmdQueryLoan(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "loan [loanID]",
+		Short: "query a loan collateralized by an NFT",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Get the terms, the collateral and the lender of a loan.
+Example:
+$ %s query %s loan 1
+`, version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			loanID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			params := types.NewQueryLoanParams(loanID)
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/loan", queryRoute), bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.Loan
+			err = cdc.UnmarshalJSON(res, &out)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdQueryLoans queries all the loans, optionally of a borrower or a lender
+func GetCmdQueryLoans(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "loans",
+		Short: "query the loans collateralized by NFTs",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Get all the loans and loan requests, optionally only those of a borrower or a lender.
+Example:
+$ %s query %s loans --borrower cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`, version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			var borrower, lender sdk.AccAddress
+			var err error
+			if s := viper.GetString(flagBorrower); s != "" {
+				borrower, err = sdk.AccAddressFromBech32(s)
+				if err != nil {
+					return err
+				}
+			}
+			if s := viper.GetString(flagLender); s != "" {
+				lender, err = sdk.AccAddressFromBech32(s)
+				if err != nil {
+					return err
+				}
+			}
+
+			params := types.NewQueryLoansParams(borrower, lender)
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/loans", queryRoute), bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.Loans
+			err = cdc.UnmarshalJSON(res, &out)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(out)
+		},
+	}
+
+	cmd.Flags().String(flagBorrower, "", "Only list the loans of this borrower")
+	cmd.Flags().String(flagLender, "", "Only list the loans of this lender")
+	return cmd
+}
+
+// GetCmdQueryVault queries the vault of a fractionalized NFT
+func GetCmdQueryVault(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "vault [denom] [tokenID]",
+		Short: "query the vault of a fractionalized NFT",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Get the share denom, the shares in circulation and the buyout state of a fractionalized NFT.
+Example:
+$ %s query %s vault collectables d04b98f48e8f8bcc15c6ae5ac050801cd6dcfd428fb5f9e65c4e16e7807340fa
+`, version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			params := types.NewQueryNFTParams(args[0], args[1])
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/vault", queryRoute), bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.Vault
+			err = cdc.UnmarshalJSON(res, &out)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdQueryVaults queries all the vaults, optionally of a collection
+func GetCmdQueryVaults(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vaults",
+		Short: "query the vaults of fractionalized NFTs",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Get all the vaults of fractionalized NFTs, optionally only those of a collection.
+Example:
+$ %s query %s vaults --denom collectables
+`, version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			params := types.NewQueryVaultsParams(viper.GetString(flagDenom))
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/vaults", queryRoute), bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.Vaults
+			err = cdc.UnmarshalJSON(res, &out)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(out)
+		},
+	}
+
+	cmd.Flags().String(flagDenom, "", "Only list the vaults of this collection")
+	return cmd
+}
+
+// GetCmdQueryBundle queries the NFTs wrapped by a bundle
+func GetCmdQueryBundle(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "bundle [bundleID]",
+		Short: "query the NFTs wrapped by a bundle",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Get the NFTs wrapped by a bundle NFT of the %s collection.
+Example:
+$ %s query %s bundle 1
+`, types.BundleDenom, version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			params := types.NewQueryBundleParams(args[0])
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/bundle", queryRoute), bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.Bundle
+			err = cdc.UnmarshalJSON(res, &out)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdQuerySwap queries a swap by its ID
+func GetCmdQuerySwap(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "swap [swapID]",
+		Short: "query a swap of NFTs",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Get what a swap offers and what it requests in exchange.
+Example:
+$ %s query %s swap 1
+`, version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			swapID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			params := types.NewQuerySwapParams(swapID)
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/swap", queryRoute), bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.Swap
+			err = cdc.UnmarshalJSON(res, &out)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdQuerySwaps queries all the swaps, optionally of a proposer or addressed to a counterparty
+func GetCmdQuerySwaps(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "swaps",
+		Short: "query the swaps of NFTs",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Get all the open swaps, optionally only those of a proposer or addressed to a counterparty.
+Example:
+$ %s query %s swaps --counterparty cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`, version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			var proposer, counterparty sdk.AccAddress
+			var err error
+			if s := viper.GetString(flagProposer); s != "" {
+				proposer, err = sdk.AccAddressFromBech32(s)
+				if err != nil {
+					return err
+				}
+			}
+			if s := viper.GetString(flagCounterparty); s != "" {
+				counterparty, err = sdk.AccAddressFromBech32(s)
+				if err != nil {
+					return err
+				}
+			}
+
+			params := types.NewQuerySwapsParams(proposer, counterparty)
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/swaps", queryRoute), bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.Swaps
+			err = cdc.UnmarshalJSON(res, &out)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(out)
+		},
+	}
+
+	cmd.Flags().String(flagProposer, "", "Only list the swaps of this proposer")
+	cmd.Flags().String(flagCounterparty, "", "Only list the swaps addressed to this account")
+	return cmd
+}
+
+// GetCmdQueryEquipment queries the NFTs equipped onto an NFT
+func GetCmdQueryEquipment(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "equipment [denom] [tokenID]",
+		Short: "query the NFTs equipped onto an NFT",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Get the NFTs equipped directly onto an NFT and its stats including the bonus of all its equipment.
+Example:
+$ %s query %s equipment fighters d04b98f48e8f8bcc15c6ae5ac050801cd6dcfd428fb5f9e65c4e16e7807340fa
+`, version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			params := types.NewQueryNFTParams(args[0], args[1])
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/equipment", queryRoute), bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.Equipment
+			err = cdc.UnmarshalJSON(res, &out)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdQueryHistory queries the history of an NFT
+func GetCmdQueryHistory(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "history [denom] [tokenID]",
+		Short: "query the history of an NFT",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Get the history of an NFT from the oldest entry kept: its mint, transfers, sales, challenges,
+metadata edits and burn.
+Example:
+$ %s query %s history fighters d04b98f48e8f8bcc15c6ae5ac050801cd6dcfd428fb5f9e65c4e16e7807340fa --page 2 --limit 20
+`, version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			params := types.NewQueryHistoryParams(args[0], args[1], viper.GetInt(flags.FlagPage), viper.GetInt(flags.FlagLimit))
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/history", queryRoute), bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.History
+			err = cdc.UnmarshalJSON(res, &out)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(out)
+		},
+	}
+
+	cmd.Flags().Int(flags.FlagPage, 1, "Query a specific page of the history")
+	cmd.Flags().Int(flags.FlagLimit, int(types.DefaultHistoryLimit), "Number of history entries per page")
+	return cmd
+}
+
+// GetCmdQueryCreatedBy queries the NFTs minted by an address
+func GetCmdQueryCreatedBy(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "created-by [address]",
+		Short: "query the NFTs minted by an address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Get the existing NFTs minted by an address, the NFTs minted before the creators were recorded aren't listed.
+Example:
+$ %s query %s created-by cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`, version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			address, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := types.NewQueryAddressParams(address, viper.GetInt(flags.FlagPage), viper.GetInt(flags.FlagLimit))
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/createdBy", queryRoute), bz)
+			if err != nil {
+				return err
+			}
+
+			var out []types.NFTRef
+			err = cdc.UnmarshalJSON(res, &out)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(out)
+		},
+	}
+
+	cmd.Flags().Int(flags.FlagPage, 1, "Query a specific page of the NFTs")
+	cmd.Flags().Int(flags.FlagLimit, types.DefaultQueryLimit, "Number of NFTs per page")
+	return cmd
+}
+
+// GetCmdQueryHeldBy queries the NFTs ever held by an address
+func GetCmdQueryHeldBy(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "held-by [address]",
+		Short: "query the NFTs ever held by an address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Get the NFTs an address owns or has owned, including the burned ones.
+Example:
+$ %s query %s held-by cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`, version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			address, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := types.NewQueryAddressParams(address, viper.GetInt(flags.FlagPage), viper.GetInt(flags.FlagLimit))
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/heldBy", queryRoute), bz)
+			if err != nil {
+				return err
+			}
+
+			var out []types.NFTRef
+			err = cdc.UnmarshalJSON(res, &out)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(out)
+		},
+	}
+
+	cmd.Flags().Int(flags.FlagPage, 1, "Query a specific page of the NFTs")
+	cmd.Flags().Int(flags.FlagLimit, types.DefaultQueryLimit, "Number of NFTs per page")
+	return cmd
+}
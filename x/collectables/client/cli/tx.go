@@ -3,6 +3,8 @@ package cli
 import (
 	"bufio"
 	"fmt"
+	"io/ioutil"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -19,12 +21,32 @@ import (
 	"github.com/tosch110/collectables/x/collectables/types"
 )
 
-// Edit metadata flags
+// Transaction and query flags
 const (
-	flagHash  = "hash"
-	flagName  = "name"
-	flagProof = "proof"
-	flagPrice = "price"
+	flagHash         = "hash"
+	flagName         = "name"
+	flagProof        = "proof"
+	flagPrice        = "price"
+	flagDescription  = "description"
+	flagTokenURI     = "token-uri"
+	flagTrait        = "trait"
+	flagSchema       = "schema"
+	flagDenom        = "denom"
+	flagRevealFile   = "reveal-file"
+	flagTransfer     = "transfer-policy"
+	flagIssuer       = "issuer"
+	flagCapability   = "issuer-capability"
+	flagFee          = "fee"
+	flagInterest     = "interest"
+	flagBorrower     = "borrower"
+	flagLender       = "lender"
+	flagReserve      = "reserve-price"
+	flagOfferNFT     = "offer-nft"
+	flagOfferCoins   = "offer-coins"
+	flagRequestNFT   = "request-nft"
+	flagRequestCoins = "request-coins"
+	flagProposer     = "proposer"
+	flagCounterparty = "counterparty"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -40,6 +62,27 @@ func GetTxCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 		GetCmdEditNFTMetadata(cdc),
 		GetCmdMintNFT(cdc),
 		GetCmdBurnNFT(cdc),
+		GetCmdCreateCollection(cdc),
+		GetCmdFreezeMetadata(cdc),
+		GetCmdRevealCollection(cdc),
+		GetCmdFreezeNFT(cdc),
+		GetCmdUnfreezeNFT(cdc),
+		GetCmdClawbackNFT(cdc),
+		GetCmdRentNFT(cdc),
+		GetCmdRequestLoan(cdc),
+		GetCmdCancelLoan(cdc),
+		GetCmdFundLoan(cdc),
+		GetCmdRepayLoan(cdc),
+		GetCmdFractionalize(cdc),
+		GetCmdRedeem(cdc),
+		GetCmdBuyout(cdc),
+		GetCmdWrapNFTs(cdc),
+		GetCmdUnwrapBundle(cdc),
+		GetCmdProposeSwap(cdc),
+		GetCmdAcceptSwap(cdc),
+		GetCmdCancelSwap(cdc),
+		GetCmdEquip(cdc),
+		GetCmdUnequip(cdc),
 	)...)
 
 	return nftTxCmd
@@ -97,7 +140,8 @@ func GetCmdEditNFTMetadata(cdc *codec.Codec) *cobra.Command {
 			specific id (SHA-256 hex hash).
 Example:
 $ %s tx %s edit-metadata collectables d04b98f48e8f8bcc15c6ae5ac050801cd6dcfd428fb5f9e65c4e16e7807340fa \
---name name --from mykey
+--name name --description "a red one" --token-uri https://example.com/1.json \
+--trait color=red --trait level=3 --from mykey
 `,
 				version.ClientName, types.ModuleName,
 			),
@@ -111,27 +155,828 @@ $ %s tx %s edit-metadata collectables d04b98f48e8f8bcc15c6ae5ac050801cd6dcfd428f
 			denom := args[0]
 			tokenID := args[1]
 			name := viper.GetString(flagName)
+			description := viper.GetString(flagDescription)
+			tokenURI := viper.GetString(flagTokenURI)
 
-			msg := types.NewMsgEditNFTMetadata(cliCtx.GetFromAddress(), tokenID, denom, name)
+			pairs, err := cmd.Flags().GetStringArray(flagTrait)
+			if err != nil {
+				return err
+			}
+			traits, err := parseTraits(pairs)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgEditNFTMetadata(cliCtx.GetFromAddress(), tokenID, denom, name, description, tokenURI, traits)
 			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
 
 	cmd.Flags().String(flagName, "", "Extra properties available for querying")
+	cmd.Flags().String(flagDescription, "", "Description of the NFT")
+	cmd.Flags().String(flagTokenURI, "", "URI for supplemental off-chain metadata (should return a JSON object)")
+	cmd.Flags().StringArray(flagTrait, []string{}, "Trait of the NFT as key=value, the type is inferred from the value")
+	return cmd
+}
+
+// GetCmdCreateCollection is the CLI command for a CreateCollection transaction
+func GetCmdCreateCollection(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-collection [denom]",
+		Short: "create an empty collection owned by the sender",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create an empty collection with an optional schema of the traits
+			its NFTs are allowed to have. With --reveal-file the collection is a delayed reveal:
+			only the commitment of the final metadata in the file is published, the file itself
+			is published later with the reveal command. The powers of a compliance --issuer are
+			declared with --issuer-capability and can't be changed later.
+Example:
+$ %s tx %s create-collection fighters --schema color:string --schema level:number --from mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := authtypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			denom := args[0]
+
+			defs, err := cmd.Flags().GetStringArray(flagSchema)
+			if err != nil {
+				return err
+			}
+
+			var schema types.TraitSchema
+			for _, s := range defs {
+				def, err := types.ParseTraitDefinition(s)
+				if err != nil {
+					return err
+				}
+				schema = append(schema, def)
+			}
+
+			commitment := ""
+			if path := viper.GetString(flagRevealFile); path != "" {
+				metadata, err := readRevealMetadata(cdc, path)
+				if err != nil {
+					return err
+				}
+				commitment = metadata.Commitment()
+			}
+
+			policy, err := types.ParseTransferPolicy(viper.GetString(flagTransfer))
+			if err != nil {
+				return err
+			}
+
+			var issuer sdk.AccAddress
+			if s := viper.GetString(flagIssuer); s != "" {
+				issuer, err = sdk.AccAddressFromBech32(s)
+				if err != nil {
+					return err
+				}
+			}
+
+			names, err := cmd.Flags().GetStringArray(flagCapability)
+			if err != nil {
+				return err
+			}
+
+			var capabilities types.IssuerCapabilities
+			for _, s := range names {
+				capability, err := types.ParseIssuerCapability(s)
+				if err != nil {
+					return err
+				}
+				capabilities = append(capabilities, capability)
+			}
+
+			msg := types.NewMsgCreateCollection(cliCtx.GetFromAddress(), denom, schema, commitment, policy, issuer, capabilities)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().StringArray(flagSchema, []string{}, "Trait allowed in the collection as key:type (string, number or bool)")
+	cmd.Flags().String(flagRevealFile, "", "JSON file with the final metadata to commit to for a delayed reveal")
+	cmd.Flags().String(flagTransfer, "", "Transfer policy of every NFT of the collection: transferable, burn_only or soulbound")
+	cmd.Flags().String(flagIssuer, "", "Compliance issuer of the collection")
+	cmd.Flags().StringArray(flagCapability, []string{}, "Capability of the issuer: freeze or clawback")
+	return cmd
+}
+
+// GetCmdRevealCollection is the CLI command for sending a RevealCollection transaction
+func GetCmdRevealCollection(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "reveal [denom] [metadata-file]",
+		Short: "publish the final metadata of a delayed reveal collection",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Replace the placeholder metadata of the NFTs of a collection with the metadata of
+			the JSON file the collection committed to when it was created. The file is a list of
+			{"id", "name", "description", "token_uri", "traits"} objects.
+Example:
+$ %s tx %s reveal fighters ./fighters.json --from mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := authtypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			metadata, err := readRevealMetadata(cdc, args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevealCollection(cliCtx.GetFromAddress(), args[0], metadata)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdFreezeNFT is the CLI command for sending a FreezeNFT transaction
+func GetCmdFreezeNFT(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "freeze-nft [denom] [tokenID]",
+		Short: "stop an NFT from changing hands as the collection issuer",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Freeze an NFT of a collection whose issuer has the freeze capability. A frozen
+			NFT can't be sent, sold, challenged or burned until it is unfrozen.
+Example:
+$ %s tx %s freeze-nft bonds d04b98f48e8f8bcc15c6ae5ac050801cd6dcfd428fb5f9e65c4e16e7807340fa \
+--from issuer
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := authtypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			msg := types.NewMsgFreezeNFT(cliCtx.GetFromAddress(), args[0], args[1])
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdUnfreezeNFT is the CLI command for sending an UnfreezeNFT transaction
+func GetCmdUnfreezeNFT(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "unfreeze-nft [denom] [tokenID]",
+		Short: "lift the freeze of an NFT as the collection issuer",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Unfreeze an NFT previously frozen by the issuer of its collection.
+Example:
+$ %s tx %s unfreeze-nft bonds d04b98f48e8f8bcc15c6ae5ac050801cd6dcfd428fb5f9e65c4e16e7807340fa \
+--from issuer
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := authtypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			msg := types.NewMsgUnfreezeNFT(cliCtx.GetFromAddress(), args[0], args[1])
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdClawbackNFT is the CLI command for sending a ClawbackNFT transaction
+func GetCmdClawbackNFT(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "clawback [denom] [tokenID] [recipient]",
+		Short: "move an NFT to a recipient as the collection issuer",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Reclaim an NFT of a collection whose issuer has the clawback capability and give
+			it to the recipient, whatever its transfer policy or freeze.
+Example:
+$ %s tx %s clawback bonds d04b98f48e8f8bcc15c6ae5ac050801cd6dcfd428fb5f9e65c4e16e7807340fa \
+cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p --from issuer
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := authtypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			recipient, err := sdk.AccAddressFromBech32(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgClawbackNFT(cliCtx.GetFromAddress(), args[0], args[1], recipient)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdRentNFT is the CLI command for sending a RentNFT transaction
+func GetCmdRentNFT(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rent [denom] [tokenID] [renter] [blocks]",
+		Short: "grant the usage rights of an NFT to a renter for a number of blocks",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Rent an NFT out while keeping its ownership. Until the rental expires the renter
+			can challenge with the NFT and nobody can send, sell or burn it. A rental with a --fee
+			is paid by the renter, who has to sign the transaction too.
+Example:
+$ %s tx %s rent collectables d04b98f48e8f8bcc15c6ae5ac050801cd6dcfd428fb5f9e65c4e16e7807340fa \
+cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p 1000 --fee 10stake --from mykey --generate-only
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := authtypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			renter, err := sdk.AccAddressFromBech32(args[2])
+			if err != nil {
+				return err
+			}
+
+			duration, err := strconv.ParseInt(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			fee, err := sdk.ParseCoins(viper.GetString(flagFee))
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRentNFT(cliCtx.GetFromAddress(), args[0], args[1], renter, duration, fee)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagFee, "", "Rental fee paid by the renter to the owner")
+	return cmd
+}
+
+// GetCmdRequestLoan is the CLI command for sending a RequestLoan transaction
+func GetCmdRequestLoan(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "request-loan [denom] [tokenID] [principal] [blocks]",
+		Short: "lock an NFT in escrow as the collateral of a loan request",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Request a loan of the principal with an NFT as collateral. The NFT is held in escrow
+			until the loan is cancelled or repaid. If a funded loan isn't repaid within the given number
+			of blocks the NFT goes to the lender.
+Example:
+$ %s tx %s request-loan collectables d04b98f48e8f8bcc15c6ae5ac050801cd6dcfd428fb5f9e65c4e16e7807340fa \
+1000stake 10000 --interest 50stake --from mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := authtypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			principal, err := sdk.ParseCoins(args[2])
+			if err != nil {
+				return err
+			}
+
+			duration, err := strconv.ParseInt(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			interest, err := sdk.ParseCoins(viper.GetString(flagInterest))
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRequestLoan(cliCtx.GetFromAddress(), args[0], args[1], principal, interest, duration)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagInterest, "", "Interest paid to the lender on top of the principal")
+	return cmd
+}
+
+// GetCmdCancelLoan is the CLI command for sending a CancelLoan transaction
+func GetCmdCancelLoan(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "cancel-loan [loanID]",
+		Short: "cancel a loan request that wasn't funded",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel a loan request of the sender that wasn't funded yet and unlock its collateral.
+Example:
+$ %s tx %s cancel-loan 1 --from mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := authtypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			loanID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelLoan(cliCtx.GetFromAddress(), loanID)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdFundLoan is the CLI command for sending a FundLoan transaction
+func GetCmdFundLoan(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "fund-loan [loanID]",
+		Short: "lend the principal of a loan request",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Send the principal of a loan request to its borrower. The sender becomes the lender
+			and receives the collateral if the loan isn't repaid in time.
+Example:
+$ %s tx %s fund-loan 1 --from mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := authtypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			loanID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgFundLoan(cliCtx.GetFromAddress(), loanID)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdRepayLoan is the CLI command for sending a RepayLoan transaction
+func GetCmdRepayLoan(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "repay-loan [loanID]",
+		Short: "repay a loan and unlock its collateral",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Send the principal and the interest of a loan of the sender to its lender and unlock
+			its collateral.
+Example:
+$ %s tx %s repay-loan 1 --from mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := authtypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			loanID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRepayLoan(cliCtx.GetFromAddress(), loanID)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func readRevealMetadata(cdc *codec.Codec, path string) (types.RevealMetadata, error) {
+	bz, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var metadata types.RevealMetadata
+	if err := cdc.UnmarshalJSON(bz, &metadata); err != nil {
+		return nil, err
+	}
+	return metadata, metadata.Validate()
+}
+
+// GetCmdFractionalize is the CLI command for sending a Fractionalize transaction
+func GetCmdFractionalize(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fractionalize [denom] [tokenID] [shares]",
+		Short: "lock an NFT in a vault and split its ownership into fungible shares",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Lock an NFT in a vault and mint the given number of shares to the sender. The shares
+			are coins of a denom derived from the NFT that can be sent and traded like any other coin.
+			With a reserve price anyone can buy the NFT out of the vault, the shareholders then redeem
+			their shares for their part of the price.
+Example:
+$ %s tx %s fractionalize collectables d04b98f48e8f8bcc15c6ae5ac050801cd6dcfd428fb5f9e65c4e16e7807340fa \
+1000000 --reserve-price 5000stake --from mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := authtypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			shares, ok := sdk.NewIntFromString(args[2])
+			if !ok {
+				return fmt.Errorf("invalid number of shares %s", args[2])
+			}
+
+			reservePrice, err := sdk.ParseCoins(viper.GetString(flagReserve))
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgFractionalize(cliCtx.GetFromAddress(), args[0], args[1], shares, reservePrice)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagReserve, "", "Price at which anyone can buy the NFT out of the vault")
+	return cmd
+}
+
+// GetCmdRedeem is the CLI command for sending a Redeem transaction
+func GetCmdRedeem(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "redeem [denom] [tokenID]",
+		Short: "burn the shares of a vaulted NFT",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Burn all the shares of a vaulted NFT held by the sender. If the sender holds every share
+			the NFT is withdrawn from the vault, if the NFT was bought out the sender gets its part of the price.
+Example:
+$ %s tx %s redeem collectables d04b98f48e8f8bcc15c6ae5ac050801cd6dcfd428fb5f9e65c4e16e7807340fa --from mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := authtypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			msg := types.NewMsgRedeem(cliCtx.GetFromAddress(), args[0], args[1])
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdBuyout is the CLI command for sending a Buyout transaction
+func GetCmdBuyout(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "buyout [denom] [tokenID]",
+		Short: "buy a vaulted NFT at its reserve price",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Pay the reserve price of a vaulted NFT to its shareholders and take the NFT out of the vault.
+Example:
+$ %s tx %s buyout collectables d04b98f48e8f8bcc15c6ae5ac050801cd6dcfd428fb5f9e65c4e16e7807340fa --from mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := authtypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			msg := types.NewMsgBuyout(cliCtx.GetFromAddress(), args[0], args[1])
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdWrapNFTs is the CLI command for sending a WrapNFTs transaction
+func GetCmdWrapNFTs(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "wrap [denom/tokenID] [denom/tokenID]...",
+		Short: "wrap several NFTs into a bundle NFT",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Lock NFTs of the sender, possibly of different collections, in escrow and mint a bundle
+			NFT of the %s collection representing them. The bundle can be sent, listed and sold like any
+			other NFT, its owner can unwrap it to get the NFTs back.
+Example:
+$ %s tx %s wrap fighters/d04b98f48e8f8bcc15c6ae5ac050801cd6dcfd428fb5f9e65c4e16e7807340fa \
+weapons/a9f1 --from mykey
+`,
+				types.BundleDenom, version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := authtypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			components := make([]types.NFTRef, len(args))
+			for i, arg := range args {
+				ref, err := types.ParseNFTRef(arg)
+				if err != nil {
+					return err
+				}
+				components[i] = ref
+			}
+
+			msg := types.NewMsgWrapNFTs(cliCtx.GetFromAddress(), components)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdUnwrapBundle is the CLI command for sending a UnwrapBundle transaction
+func GetCmdUnwrapBundle(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "unwrap [bundleID]",
+		Short: "unwrap a bundle NFT",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Burn a bundle NFT of the sender and get the NFTs it wraps back.
+Example:
+$ %s tx %s unwrap 1 --from mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := authtypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			msg := types.NewMsgUnwrapBundle(cliCtx.GetFromAddress(), args[0])
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdProposeSwap is the CLI command for sending a ProposeSwap transaction
+func GetCmdProposeSwap(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "propose-swap [counterparty]",
+		Short: "offer NFTs and coins to an account in exchange for its NFTs and coins",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Propose a swap to a counterparty. The offered NFTs and coins are held in escrow until the
+			counterparty accepts the swap, which exchanges both sides at once, or the sender cancels it.
+Example:
+$ %s tx %s propose-swap cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p \
+--offer-nft fighters/d04b98f48e8f8bcc15c6ae5ac050801cd6dcfd428fb5f9e65c4e16e7807340fa --offer-coins 10stake \
+--request-nft weapons/a9f1 --from mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := authtypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			counterparty, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			offeredNFTs, err := readNFTRefs(cmd, flagOfferNFT)
+			if err != nil {
+				return err
+			}
+			offeredCoins, err := sdk.ParseCoins(viper.GetString(flagOfferCoins))
+			if err != nil {
+				return err
+			}
+			requestedNFTs, err := readNFTRefs(cmd, flagRequestNFT)
+			if err != nil {
+				return err
+			}
+			requestedCoins, err := sdk.ParseCoins(viper.GetString(flagRequestCoins))
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgProposeSwap(cliCtx.GetFromAddress(), counterparty,
+				offeredNFTs, offeredCoins, requestedNFTs, requestedCoins)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().StringArray(flagOfferNFT, []string{}, "NFT offered by the sender as denom/tokenID")
+	cmd.Flags().String(flagOfferCoins, "", "Coins offered by the sender")
+	cmd.Flags().StringArray(flagRequestNFT, []string{}, "NFT requested from the counterparty as denom/tokenID")
+	cmd.Flags().String(flagRequestCoins, "", "Coins requested from the counterparty")
 	return cmd
 }
 
+// GetCmdAcceptSwap is the CLI command for sending a AcceptSwap transaction
+func GetCmdAcceptSwap(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "accept-swap [swapID]",
+		Short: "accept a swap addressed to the sender",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Exchange the NFTs and coins requested by a swap addressed to the sender for the ones it offers.
+Example:
+$ %s tx %s accept-swap 1 --from mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := authtypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			swapID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAcceptSwap(cliCtx.GetFromAddress(), swapID)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdCancelSwap is the CLI command for sending a CancelSwap transaction
+func GetCmdCancelSwap(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "cancel-swap [swapID]",
+		Short: "cancel a swap proposed by the sender",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel a swap proposed by the sender and unlock the NFTs and coins it offered.
+Example:
+$ %s tx %s cancel-swap 1 --from mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := authtypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			swapID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelSwap(cliCtx.GetFromAddress(), swapID)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// readNFTRefs parses the NFT references given as denom/tokenID to an array flag
+func readNFTRefs(cmd *cobra.Command, flag string) ([]types.NFTRef, error) {
+	args, err := cmd.Flags().GetStringArray(flag)
+	if err != nil {
+		return nil, err
+	}
+	refs := make([]types.NFTRef, len(args))
+	for i, arg := range args {
+		if refs[i], err = types.ParseNFTRef(arg); err != nil {
+			return nil, err
+		}
+	}
+	return refs, nil
+}
+
+// GetCmdEquip is the CLI command for sending an Equip transaction
+func GetCmdEquip(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "equip [denom] [tokenID] [parentDenom] [parentID]",
+		Short: "equip an NFT onto a parent NFT",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Equip an item onto a fighter or onto another item held by the sender. The equipped item
+			follows its parent when the parent changes hands and adds its stats to the fighter in challenges.
+Example:
+$ %s tx %s equip items sword fighters d04b98f48e8f8bcc15c6ae5ac050801cd6dcfd428fb5f9e65c4e16e7807340fa --from mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := authtypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			msg := types.NewMsgEquip(cliCtx.GetFromAddress(), args[0], args[1], args[2], args[3])
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdUnequip is the CLI command for sending an Unequip transaction
+func GetCmdUnequip(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "unequip [denom] [tokenID]",
+		Short: "detach an equipped NFT from its parent",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Detach an equipped item from its parent and give it back to the sender, who must hold
+			the fighter or item at the top of its equipment.
+Example:
+$ %s tx %s unequip items sword --from mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := authtypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			msg := types.NewMsgUnequip(cliCtx.GetFromAddress(), args[0], args[1])
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdFreezeMetadata is the CLI command for sending a FreezeMetadata transaction
+func GetCmdFreezeMetadata(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "freeze [denom] [tokenID]",
+		Short: "permanently freeze the metadata of an NFT or of a whole collection",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Freeze the metadata of an NFT owned by the sender or, when no tokenID is
+			given, of every NFT of a collection created by the sender. This can't be undone.
+Example:
+$ %s tx %s freeze collectables d04b98f48e8f8bcc15c6ae5ac050801cd6dcfd428fb5f9e65c4e16e7807340fa \
+--from mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := authtypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			denom := args[0]
+			tokenID := ""
+			if len(args) > 1 {
+				tokenID = args[1]
+			}
+
+			msg := types.NewMsgFreezeMetadata(cliCtx.GetFromAddress(), denom, tokenID)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func parseTraits(pairs []string) (types.Traits, error) {
+	var traits types.Traits
+	for _, pair := range pairs {
+		trait, err := types.ParseTrait(pair)
+		if err != nil {
+			return nil, err
+		}
+		traits = append(traits, trait)
+	}
+	return types.NewTraits(traits...), nil
+}
+
 // GetCmdMintNFT is the CLI command for a MintNFT transaction
 func GetCmdMintNFT(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "mint [denom] [hash] [proof] [name] [recipient]",
+		Use:   "mint [denom] [tokenID] [recipient]",
 		Short: "mint an NFT and set the owner to the recipient",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Mint an NFT from a given collection that has a 
 			specific id (SHA-256 hex hash) and set the ownership to a specific address.
+The hash has to be the blake3 hash of the proof.
 Example:
-$ %s tx %s mint collectables 03128C68F894E689F009ABD69653297BF111CDC43B9291A5469D8D1C52608C5AEED107A0FE500C3AF7E73F90E3B7CF20 thisismyexampletokeninputforthedemo demo \
-cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p --from mykey
+$ %s tx %s mint collectables d04b98f48e8f8bcc15c6ae5ac050801cd6dcfd428fb5f9e65c4e16e7807340fa \
+cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p --proof thisismyexampletokeninputforthedemo \
+--hash <blake3 hash of the proof> --name demo --from mykey
 `,
 				version.ClientName, types.ModuleName,
 			),
@@ -151,8 +996,8 @@ cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p --from mykey
 			}
 
 			hash := viper.GetString(flagHash)
-			name := viper.GetString(flagProof)
-			proof := viper.GetString(flagName)
+			proof := viper.GetString(flagProof)
+			name := viper.GetString(flagName)
 			price := viper.GetString(flagPrice)
 
 			priceCoins, err := sdk.ParseCoins(price)
@@ -160,12 +1005,21 @@ cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p --from mykey
 				return err
 			}
 
-			msg := types.NewMsgMintNFT(cliCtx.GetFromAddress(), recipient, tokenID, denom, hash, proof, name, priceCoins)
+			policy, err := types.ParseTransferPolicy(viper.GetString(flagTransfer))
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgMintNFT(cliCtx.GetFromAddress(), recipient, tokenID, denom, hash, proof, name, priceCoins, policy)
 			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
 
-	cmd.Flags().String(flagName, "", "URI for supplemental off-chain metadata (should return a JSON object)")
+	cmd.Flags().String(flagHash, "", "blake3 hash of the proof (64 hex characters)")
+	cmd.Flags().String(flagProof, "", "Proof, input for the hash (25-255 printable characters)")
+	cmd.Flags().String(flagName, "", "The user given name of the NFT")
+	cmd.Flags().String(flagPrice, "", "Price set for the NFT")
+	cmd.Flags().String(flagTransfer, "", "Transfer policy of the NFT: transferable, burn_only or soulbound")
 
 	return cmd
 }
@@ -43,6 +43,86 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Co
 	r.HandleFunc(
 		"/nft/collection/{denom}/nft/{id}", getNFT(cdc, cliCtx, queryRoute),
 	).Methods("GET")
+
+	// Search NFTs by their traits
+	r.HandleFunc(
+		"/nft/search", searchNFTs(cdc, cliCtx, queryRoute),
+	).Methods("GET")
+
+	// Query the rarity of a single NFT
+	r.HandleFunc(
+		"/nft/collection/{denom}/nft/{id}/rarity", getRarity(cdc, cliCtx, queryRoute),
+	).Methods("GET")
+
+	// Query a loan collateralized by an NFT
+	r.HandleFunc(
+		"/nft/loans/{id}", getLoan(cdc, cliCtx, queryRoute),
+	).Methods("GET")
+
+	// Query the loans, optionally of a borrower or a lender
+	r.HandleFunc(
+		"/nft/loans", getLoans(cdc, cliCtx, queryRoute),
+	).Methods("GET")
+
+	// Query the vault of a fractionalized NFT
+	r.HandleFunc(
+		"/nft/collection/{denom}/nft/{id}/vault", getVault(cdc, cliCtx, queryRoute),
+	).Methods("GET")
+
+	// Query the vaults, optionally of a collection
+	r.HandleFunc(
+		"/nft/vaults", getVaults(cdc, cliCtx, queryRoute),
+	).Methods("GET")
+
+	// Query the NFTs wrapped by a bundle
+	r.HandleFunc(
+		"/nft/bundles/{id}", getBundle(cdc, cliCtx, queryRoute),
+	).Methods("GET")
+
+	// Query a swap of NFTs
+	r.HandleFunc(
+		"/nft/swaps/{id}", getSwap(cdc, cliCtx, queryRoute),
+	).Methods("GET")
+
+	// Query the swaps, optionally of a proposer or addressed to a counterparty
+	r.HandleFunc(
+		"/nft/swaps", getSwaps(cdc, cliCtx, queryRoute),
+	).Methods("GET")
+
+	// Query the NFTs equipped onto an NFT
+	r.HandleFunc(
+		"/nft/collection/{denom}/nft/{id}/equipment", getEquipment(cdc, cliCtx, queryRoute),
+	).Methods("GET")
+
+	// Query the history of an NFT
+	r.HandleFunc(
+		"/nft/collection/{denom}/nft/{id}/history", getHistory(cdc, cliCtx, queryRoute),
+	).Methods("GET")
+
+	// Query the NFTs minted by an address
+	r.HandleFunc(
+		"/nft/created-by/{address}", getRefsByAddress(cdc, cliCtx, queryRoute, "createdBy"),
+	).Methods("GET")
+
+	// Query the NFTs ever held by an address
+	r.HandleFunc(
+		"/nft/held-by/{address}", getRefsByAddress(cdc, cliCtx, queryRoute, "heldBy"),
+	).Methods("GET")
+
+	// Query the reveal status of a collection
+	r.HandleFunc(
+		"/nft/collection/{denom}/reveal", getReveal(cdc, cliCtx, queryRoute),
+	).Methods("GET")
+
+	// Query the NFTs of a collection ranked by rarity
+	r.HandleFunc(
+		"/nft/collection/{denom}/rarity", getRarityRank(cdc, cliCtx, queryRoute),
+	).Methods("GET")
+
+	// Query the NFTs minted with a hash
+	r.HandleFunc(
+		"/nft/hash/{hash}", getNFTsByHash(cdc, cliCtx, queryRoute),
+	).Methods("GET")
 }
 
 func getSupply(cdc *codec.Codec, cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
@@ -173,3 +253,390 @@ func getNFT(cdc *codec.Codec, cliCtx context.CLIContext, queryRoute string) http
 		rest.PostProcessResponse(w, cliCtx, res)
 	}
 }
+
+func getNFTsByHash(cdc *codec.Codec, cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		hash := mux.Vars(r)["hash"]
+
+		params := types.NewQueryHashParams(hash)
+		bz, err := cdc.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/byHash", queryRoute), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func searchNFTs(cdc *codec.Codec, cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+
+		var traits types.Traits
+		for _, pair := range query["trait"] {
+			trait, err := types.ParseTrait(pair)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			traits = append(traits, trait)
+		}
+
+		params := types.NewQuerySearchParams(query.Get("denom"), types.NewTraits(traits...))
+		bz, err := cdc.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/search", queryRoute), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func getRarity(cdc *codec.Codec, cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		params := types.NewQueryNFTParams(vars["denom"], vars["id"])
+		bz, err := cdc.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/rarity", queryRoute), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func getRarityRank(cdc *codec.Codec, cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		denom := mux.Vars(r)["denom"]
+
+		params := types.NewQueryCollectionParams(denom)
+		bz, err := cdc.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/rarityRank", queryRoute), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func getReveal(cdc *codec.Codec, cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		denom := mux.Vars(r)["denom"]
+
+		params := types.NewQueryCollectionParams(denom)
+		bz, err := cdc.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/reveal", queryRoute), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func getLoan(cdc *codec.Codec, cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		loanID, ok := rest.ParseUint64OrReturnBadRequest(w, mux.Vars(r)["id"])
+		if !ok {
+			return
+		}
+
+		params := types.NewQueryLoanParams(loanID)
+		bz, err := cdc.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/loan", queryRoute), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func getLoans(cdc *codec.Codec, cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+
+		var borrower, lender sdk.AccAddress
+		var err error
+		if s := query.Get("borrower"); s != "" {
+			borrower, err = sdk.AccAddressFromBech32(s)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+		if s := query.Get("lender"); s != "" {
+			lender, err = sdk.AccAddressFromBech32(s)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
+		params := types.NewQueryLoansParams(borrower, lender)
+		bz, err := cdc.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/loans", queryRoute), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func getVault(cdc *codec.Codec, cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		params := types.NewQueryNFTParams(vars["denom"], vars["id"])
+		bz, err := cdc.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/vault", queryRoute), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func getVaults(cdc *codec.Codec, cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		params := types.NewQueryVaultsParams(r.URL.Query().Get("denom"))
+		bz, err := cdc.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/vaults", queryRoute), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func getBundle(cdc *codec.Codec, cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		params := types.NewQueryBundleParams(mux.Vars(r)["id"])
+		bz, err := cdc.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/bundle", queryRoute), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func getSwap(cdc *codec.Codec, cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		swapID, ok := rest.ParseUint64OrReturnBadRequest(w, mux.Vars(r)["id"])
+		if !ok {
+			return
+		}
+
+		params := types.NewQuerySwapParams(swapID)
+		bz, err := cdc.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/swap", queryRoute), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func getSwaps(cdc *codec.Codec, cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+
+		var proposer, counterparty sdk.AccAddress
+		var err error
+		if s := query.Get("proposer"); s != "" {
+			proposer, err = sdk.AccAddressFromBech32(s)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+		if s := query.Get("counterparty"); s != "" {
+			counterparty, err = sdk.AccAddressFromBech32(s)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
+		params := types.NewQuerySwapsParams(proposer, counterparty)
+		bz, err := cdc.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/swaps", queryRoute), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func getEquipment(cdc *codec.Codec, cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		params := types.NewQueryNFTParams(vars["denom"], vars["id"])
+		bz, err := cdc.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/equipment", queryRoute), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func getHistory(cdc *codec.Codec, cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		err := r.ParseForm()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		_, page, limit, err := rest.ParseHTTPArgsWithLimit(r, int(types.DefaultHistoryLimit))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		params := types.NewQueryHistoryParams(vars["denom"], vars["id"], page, limit)
+		bz, err := cdc.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/history", queryRoute), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func getRefsByAddress(cdc *codec.Codec, cliCtx context.CLIContext, queryRoute, query string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		address, err := sdk.AccAddressFromBech32(mux.Vars(r)["address"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		err = r.ParseForm()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		_, page, limit, err := rest.ParseHTTPArgsWithLimit(r, types.DefaultQueryLimit)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		params := types.NewQueryAddressParams(address, page, limit)
+		bz, err := cdc.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, query), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
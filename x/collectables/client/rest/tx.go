@@ -57,6 +57,132 @@ func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router,
 		buyNFTHandler(cdc, cliCtx),
 	).Methods("POST")
 
+	// Create a collection
+	r.HandleFunc(
+		"/nfts/collection",
+		createCollectionHandler(cdc, cliCtx),
+	).Methods("POST")
+
+	// Freeze the metadata of a collection or of a single NFT
+	r.HandleFunc(
+		"/nfts/collection/{denom}/freeze",
+		freezeMetadataHandler(cdc, cliCtx),
+	).Methods("PUT")
+
+	// Reveal the metadata of a collection
+	r.HandleFunc(
+		"/nfts/collection/{denom}/reveal",
+		revealCollectionHandler(cdc, cliCtx),
+	).Methods("PUT")
+
+	// Freeze an NFT as the collection issuer
+	r.HandleFunc(
+		"/nfts/collection/{denom}/nft/{id}/freeze",
+		freezeNFTHandler(cdc, cliCtx),
+	).Methods("PUT")
+
+	// Unfreeze an NFT as the collection issuer
+	r.HandleFunc(
+		"/nfts/collection/{denom}/nft/{id}/unfreeze",
+		unfreezeNFTHandler(cdc, cliCtx),
+	).Methods("PUT")
+
+	// Claw an NFT back as the collection issuer
+	r.HandleFunc(
+		"/nfts/collection/{denom}/nft/{id}/clawback",
+		clawbackNFTHandler(cdc, cliCtx),
+	).Methods("PUT")
+
+	// Rent an NFT out
+	r.HandleFunc(
+		"/nfts/collection/{denom}/nft/{id}/rent",
+		rentNFTHandler(cdc, cliCtx),
+	).Methods("POST")
+
+	// Request a loan with an NFT as collateral
+	r.HandleFunc(
+		"/nfts/loans",
+		requestLoanHandler(cdc, cliCtx),
+	).Methods("POST")
+
+	// Cancel a loan request
+	r.HandleFunc(
+		"/nfts/loans/{id}/cancel",
+		cancelLoanHandler(cdc, cliCtx),
+	).Methods("PUT")
+
+	// Fund a loan request
+	r.HandleFunc(
+		"/nfts/loans/{id}/fund",
+		fundLoanHandler(cdc, cliCtx),
+	).Methods("PUT")
+
+	// Repay a loan
+	r.HandleFunc(
+		"/nfts/loans/{id}/repay",
+		repayLoanHandler(cdc, cliCtx),
+	).Methods("PUT")
+
+	// Lock an NFT in a vault and mint its shares
+	r.HandleFunc(
+		"/nfts/collection/{denom}/nft/{id}/fractionalize",
+		fractionalizeHandler(cdc, cliCtx),
+	).Methods("POST")
+
+	// Burn the shares of a vaulted NFT
+	r.HandleFunc(
+		"/nfts/collection/{denom}/nft/{id}/redeem",
+		redeemHandler(cdc, cliCtx),
+	).Methods("PUT")
+
+	// Buy a vaulted NFT at its reserve price
+	r.HandleFunc(
+		"/nfts/collection/{denom}/nft/{id}/buyout",
+		buyoutHandler(cdc, cliCtx),
+	).Methods("PUT")
+
+	// Wrap several NFTs into a bundle
+	r.HandleFunc(
+		"/nfts/bundles",
+		wrapNFTsHandler(cdc, cliCtx),
+	).Methods("POST")
+
+	// Unwrap a bundle
+	r.HandleFunc(
+		"/nfts/bundles/{id}/unwrap",
+		unwrapBundleHandler(cdc, cliCtx),
+	).Methods("PUT")
+
+	// Propose a swap of NFTs and coins
+	r.HandleFunc(
+		"/nfts/swaps",
+		proposeSwapHandler(cdc, cliCtx),
+	).Methods("POST")
+
+	// Accept a swap
+	r.HandleFunc(
+		"/nfts/swaps/{id}/accept",
+		acceptSwapHandler(cdc, cliCtx),
+	).Methods("PUT")
+
+	// Cancel a swap
+	r.HandleFunc(
+		"/nfts/swaps/{id}/cancel",
+		cancelSwapHandler(cdc, cliCtx),
+	).Methods("PUT")
+
+	// Equip an NFT onto a parent NFT
+	r.HandleFunc(
+		"/nfts/collection/{denom}/nft/{id}/equip",
+		equipHandler(cdc, cliCtx),
+	).Methods("PUT")
+
+	// Detach an equipped NFT from its parent
+	r.HandleFunc(
+		"/nfts/collection/{denom}/nft/{id}/unequip",
+		unequipHandler(cdc, cliCtx),
+	).Methods("PUT")
+
 }
 
 type sendNFTReq struct {
@@ -90,10 +216,13 @@ func sendNFTHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFun
 }
 
 type editNFTMetadataReq struct {
-	BaseReq rest.BaseReq `json:"base_req"`
-	Denom   string       `json:"denom"`
-	ID      string       `json:"id"`
-	Name    string       `json:"name"`
+	BaseReq     rest.BaseReq `json:"base_req"`
+	Denom       string       `json:"denom"`
+	ID          string       `json:"id"`
+	Name        string       `json:"name"`
+	Description string       `json:"description"`
+	TokenURI    string       `json:"token_uri"`
+	Traits      types.Traits `json:"traits"`
 }
 
 func editNFTMetadataHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
@@ -109,7 +238,7 @@ func editNFTMetadataHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.Ha
 		}
 
 		// create the message
-		msg := types.NewMsgEditNFTMetadata(cliCtx.GetFromAddress(), req.ID, req.Denom, req.Name)
+		msg := types.NewMsgEditNFTMetadata(cliCtx.GetFromAddress(), req.ID, req.Denom, req.Name, req.Description, req.TokenURI, req.Traits)
 
 		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
 	}
@@ -124,6 +253,8 @@ type mintNFTReq struct {
 	Hash      string         `json:"hash"`
 	Proof     string         `json:"proof"`
 	Price     sdk.Coins      `json:"price"`
+	// TransferPolicy is one of transferable, burn_only or soulbound
+	TransferPolicy types.TransferPolicy `json:"transfer_policy"`
 }
 
 func mintNFTHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
@@ -139,7 +270,7 @@ func mintNFTHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFun
 		}
 
 		// create the message
-		msg := types.NewMsgMintNFT(cliCtx.GetFromAddress(), req.Recipient, req.ID, req.Denom, req.Name, req.Hash, req.Proof, req.Price)
+		msg := types.NewMsgMintNFT(cliCtx.GetFromAddress(), req.Recipient, req.ID, req.Denom, req.Hash, req.Proof, req.Name, req.Price, req.TransferPolicy)
 
 		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
 	}
@@ -244,3 +375,517 @@ func challengeNFTHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.Handl
 		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
 	}
 }
+
+type createCollectionReq struct {
+	BaseReq rest.BaseReq      `json:"base_req"`
+	Denom   string            `json:"denom"`
+	Schema  types.TraitSchema `json:"schema"`
+	// Commitment is the blake3 hash of the final metadata for a delayed reveal
+	Commitment string `json:"commitment"`
+	// TransferPolicy is one of transferable, burn_only or soulbound
+	TransferPolicy types.TransferPolicy `json:"transfer_policy"`
+	// Issuer is the optional compliance issuer holding the IssuerCapabilities
+	Issuer             sdk.AccAddress           `json:"issuer"`
+	IssuerCapabilities types.IssuerCapabilities `json:"issuer_capabilities"`
+}
+
+func createCollectionHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req createCollectionReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgCreateCollection(cliCtx.GetFromAddress(), req.Denom, req.Schema, req.Commitment, req.TransferPolicy, req.Issuer, req.IssuerCapabilities)
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type freezeMetadataReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+	Denom   string       `json:"denom"`
+	ID      string       `json:"id"`
+}
+
+func freezeMetadataHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req freezeMetadataReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgFreezeMetadata(cliCtx.GetFromAddress(), req.Denom, req.ID)
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type revealCollectionReq struct {
+	BaseReq  rest.BaseReq         `json:"base_req"`
+	Denom    string               `json:"denom"`
+	Metadata types.RevealMetadata `json:"metadata"`
+}
+
+func revealCollectionHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req revealCollectionReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgRevealCollection(cliCtx.GetFromAddress(), req.Denom, req.Metadata)
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type issuerNFTReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+	Denom   string       `json:"denom"`
+	ID      string       `json:"id"`
+}
+
+func freezeNFTHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req issuerNFTReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgFreezeNFT(cliCtx.GetFromAddress(), req.Denom, req.ID)
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+func unfreezeNFTHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req issuerNFTReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgUnfreezeNFT(cliCtx.GetFromAddress(), req.Denom, req.ID)
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type clawbackNFTReq struct {
+	BaseReq   rest.BaseReq   `json:"base_req"`
+	Denom     string         `json:"denom"`
+	ID        string         `json:"id"`
+	Recipient sdk.AccAddress `json:"recipient"`
+}
+
+func clawbackNFTHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req clawbackNFTReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgClawbackNFT(cliCtx.GetFromAddress(), req.Denom, req.ID, req.Recipient)
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type rentNFTReq struct {
+	BaseReq  rest.BaseReq   `json:"base_req"`
+	Denom    string         `json:"denom"`
+	ID       string         `json:"id"`
+	Renter   sdk.AccAddress `json:"renter"`
+	Duration int64          `json:"duration"`
+	Fee      sdk.Coins      `json:"fee"`
+}
+
+func rentNFTHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req rentNFTReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgRentNFT(cliCtx.GetFromAddress(), req.Denom, req.ID, req.Renter, req.Duration, req.Fee)
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type requestLoanReq struct {
+	BaseReq   rest.BaseReq `json:"base_req"`
+	Denom     string       `json:"denom"`
+	ID        string       `json:"id"`
+	Principal sdk.Coins    `json:"principal"`
+	Interest  sdk.Coins    `json:"interest"`
+	Duration  int64        `json:"duration"`
+}
+
+func requestLoanHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req requestLoanReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgRequestLoan(cliCtx.GetFromAddress(), req.Denom, req.ID, req.Principal, req.Interest, req.Duration)
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type loanReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+}
+
+func cancelLoanHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req loanReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		loanID, ok := rest.ParseUint64OrReturnBadRequest(w, mux.Vars(r)["id"])
+		if !ok {
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgCancelLoan(cliCtx.GetFromAddress(), loanID)
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+func fundLoanHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req loanReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		loanID, ok := rest.ParseUint64OrReturnBadRequest(w, mux.Vars(r)["id"])
+		if !ok {
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgFundLoan(cliCtx.GetFromAddress(), loanID)
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+func repayLoanHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req loanReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		loanID, ok := rest.ParseUint64OrReturnBadRequest(w, mux.Vars(r)["id"])
+		if !ok {
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgRepayLoan(cliCtx.GetFromAddress(), loanID)
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type fractionalizeReq struct {
+	BaseReq      rest.BaseReq `json:"base_req"`
+	Denom        string       `json:"denom"`
+	ID           string       `json:"id"`
+	Shares       sdk.Int      `json:"shares"`
+	ReservePrice sdk.Coins    `json:"reserve_price"`
+}
+
+func fractionalizeHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req fractionalizeReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgFractionalize(cliCtx.GetFromAddress(), req.Denom, req.ID, req.Shares, req.ReservePrice)
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type vaultReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+	Denom   string       `json:"denom"`
+	ID      string       `json:"id"`
+}
+
+func redeemHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req vaultReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgRedeem(cliCtx.GetFromAddress(), req.Denom, req.ID)
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+func buyoutHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req vaultReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgBuyout(cliCtx.GetFromAddress(), req.Denom, req.ID)
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type wrapNFTsReq struct {
+	BaseReq    rest.BaseReq   `json:"base_req"`
+	Components []types.NFTRef `json:"components"`
+}
+
+func wrapNFTsHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req wrapNFTsReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgWrapNFTs(cliCtx.GetFromAddress(), req.Components)
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type unwrapBundleReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+}
+
+func unwrapBundleHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req unwrapBundleReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgUnwrapBundle(cliCtx.GetFromAddress(), mux.Vars(r)["id"])
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type proposeSwapReq struct {
+	BaseReq        rest.BaseReq   `json:"base_req"`
+	Counterparty   sdk.AccAddress `json:"counterparty"`
+	OfferedNFTs    []types.NFTRef `json:"offered_nfts"`
+	OfferedCoins   sdk.Coins      `json:"offered_coins"`
+	RequestedNFTs  []types.NFTRef `json:"requested_nfts"`
+	RequestedCoins sdk.Coins      `json:"requested_coins"`
+}
+
+func proposeSwapHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req proposeSwapReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgProposeSwap(cliCtx.GetFromAddress(), req.Counterparty,
+			req.OfferedNFTs, req.OfferedCoins, req.RequestedNFTs, req.RequestedCoins)
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type swapReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+}
+
+func acceptSwapHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req swapReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		swapID, ok := rest.ParseUint64OrReturnBadRequest(w, mux.Vars(r)["id"])
+		if !ok {
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgAcceptSwap(cliCtx.GetFromAddress(), swapID)
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+func cancelSwapHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req swapReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		swapID, ok := rest.ParseUint64OrReturnBadRequest(w, mux.Vars(r)["id"])
+		if !ok {
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgCancelSwap(cliCtx.GetFromAddress(), swapID)
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type equipReq struct {
+	BaseReq     rest.BaseReq `json:"base_req"`
+	Denom       string       `json:"denom"`
+	ID          string       `json:"id"`
+	ParentDenom string       `json:"parent_denom"`
+	ParentID    string       `json:"parent_id"`
+}
+
+func equipHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req equipReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgEquip(cliCtx.GetFromAddress(), req.Denom, req.ID, req.ParentDenom, req.ParentID)
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type unequipReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+	Denom   string       `json:"denom"`
+	ID      string       `json:"id"`
+}
+
+func unequipHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req unequipReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgUnequip(cliCtx.GetFromAddress(), req.Denom, req.ID)
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
@@ -0,0 +1,106 @@
+package collectables_test
+
+import (
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	nft "github.com/tosch110/collectables/x/collectables"
+)
+
+func TestFractionRedeemAllShares(t *testing.T) {
+	ctx, k, bankKeeper := createTestInputWithBank(t)
+	nft.InitGenesis(ctx, k, nft.DefaultGenesisState())
+	mint(t, ctx, k, nft.FighterDenom, "a", alice, nil)
+
+	vault, err := k.Fractionalize(ctx, alice, nft.FighterDenom, "a", sdk.NewInt(3), sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkOwner(t, ctx, k, nft.FighterDenom, "a", nft.EscrowAddress)
+	checkEscrow(t, ctx, k)
+
+	// a holder of part of the shares can't take the NFT out of the vault
+	if err := bankKeeper.SendCoins(ctx, alice, bob, vault.SharesCoins(sdk.NewInt(1))); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := k.Redeem(ctx, alice, vault); !errors.Is(err, nft.ErrInsufficientShares) {
+		t.Fatalf("expected %v, got %v", nft.ErrInsufficientShares, err)
+	}
+	checkEscrow(t, ctx, k)
+
+	// once it holds them all again, it can
+	if err := bankKeeper.SendCoins(ctx, bob, alice, vault.SharesCoins(sdk.NewInt(1))); err != nil {
+		t.Fatal(err)
+	}
+	if _, payout, err := k.Redeem(ctx, alice, vault); err != nil || !payout.IsZero() {
+		t.Fatalf("expected the NFT to be redeemed without payout, got %s %v", payout, err)
+	}
+	checkOwner(t, ctx, k, nft.FighterDenom, "a", alice)
+	if _, found := k.GetVault(ctx, nft.FighterDenom, "a"); found {
+		t.Fatal("expected the redeemed vault to be closed")
+	}
+	if supply := k.SupplyKeeper.GetSupply(ctx).GetTotal().AmountOf(vault.ShareDenom); !supply.IsZero() {
+		t.Fatalf("expected every share to be burned, %s are left", supply)
+	}
+	checkEscrow(t, ctx, k)
+}
+
+func TestFractionRedeemAfterBuyout(t *testing.T) {
+	ctx, k, bankKeeper := createTestInputWithBank(t)
+	nft.InitGenesis(ctx, k, nft.DefaultGenesisState())
+	mint(t, ctx, k, nft.FighterDenom, "a", alice, nil)
+	price := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	bankKeeper.SetCoins(ctx, carol, price)
+
+	vault, err := k.Fractionalize(ctx, alice, nft.FighterDenom, "a", sdk.NewInt(3), price)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := bankKeeper.SendCoins(ctx, alice, bob, vault.SharesCoins(sdk.NewInt(1))); err != nil {
+		t.Fatal(err)
+	}
+
+	vault, err = k.Buyout(ctx, carol, vault)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkOwner(t, ctx, k, nft.FighterDenom, "a", carol)
+	checkEscrow(t, ctx, k)
+	if _, err := k.Buyout(ctx, carol, vault); !errors.Is(err, nft.ErrInvalidVault) {
+		t.Fatalf("expected %v, got %v", nft.ErrInvalidVault, err)
+	}
+
+	// a third of 100 rounds down, the last holder gets the dust so the escrow is emptied
+	for _, tc := range []struct {
+		holder sdk.AccAddress
+		payout int64
+	}{
+		{bob, 33},
+		{alice, 67},
+	} {
+		var payout sdk.Coins
+		vault, payout, err = k.Redeem(ctx, tc.holder, vault)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !payout.IsEqual(sdk.NewCoins(sdk.NewInt64Coin("stake", tc.payout))) {
+			t.Fatalf("expected %s to be paid %dstake, got %s", tc.holder, tc.payout, payout)
+		}
+		checkEscrow(t, ctx, k)
+	}
+	if _, _, err := k.Redeem(ctx, bob, vault); !errors.Is(err, nft.ErrInsufficientShares) {
+		t.Fatalf("expected %v, got %v", nft.ErrInsufficientShares, err)
+	}
+
+	if _, found := k.GetVault(ctx, nft.FighterDenom, "a"); found {
+		t.Fatal("expected the vault to be closed once every share is redeemed")
+	}
+	if escrow := bankKeeper.GetCoins(ctx, nft.EscrowAddress); !escrow.IsZero() {
+		t.Fatalf("expected the escrow to be empty, it holds %s", escrow)
+	}
+	if balance := bankKeeper.GetCoins(ctx, carol); !balance.IsZero() {
+		t.Fatalf("expected the buyer to have paid the reserve price, it holds %s", balance)
+	}
+}
@@ -4,16 +4,52 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// InitGenesis sets nft information for genesis.
+// InitGenesis sets nft information for genesis. The hash and trait indexes, trait counts,
+// rental expiries, equipment and creators aren't part of the genesis state and are rebuilt from
+// the collections, as are the owners and holders when the genesis state omits them.
 func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) {
-	k.SetOwners(ctx, data.Owners)
+	owners := data.Owners
+	if len(owners) == 0 {
+		owners = DeriveOwners(data.Collections)
+	}
+	holders := data.Holders
+	if len(holders) == 0 {
+		holders = DeriveHolders(data.Collections)
+	}
+	k.SetStoreVersion(ctx, ConsensusVersion)
+	k.SetParams(ctx, data.Params)
+	k.SetOwners(ctx, owners)
+	k.SetHolders(ctx, holders)
+	k.SetNextLoanID(ctx, data.NextLoanID)
+	for _, loan := range data.Loans {
+		k.SetLoan(ctx, loan)
+	}
+	for _, vault := range data.Vaults {
+		k.SetVault(ctx, vault)
+	}
+	k.SetNextBundleID(ctx, data.NextBundleID)
+	for _, bundle := range data.Bundles {
+		k.SetBundle(ctx, bundle)
+	}
+	k.SetNextSwapID(ctx, data.NextSwapID)
+	for _, swap := range data.Swaps {
+		k.SetSwap(ctx, swap)
+	}
+	for _, entry := range data.History {
+		k.SetHistoryEntry(ctx, entry)
+	}
 
 	for _, c := range data.Collections {
 		k.SetCollection(ctx, c.Denom, c)
+		for _, nft := range c.NFTs {
+			k.IndexNFT(ctx, c.Denom, nft)
+		}
 	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
 func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
-	return NewGenesisState(k.GetOwners(ctx), k.GetCollections(ctx))
+	return NewGenesisState(k.GetOwners(ctx), k.GetCollections(ctx), k.GetLoans(ctx), k.GetNextLoanID(ctx),
+		k.GetVaults(ctx), k.GetBundles(ctx), k.GetNextBundleID(ctx),
+		k.GetSwaps(ctx), k.GetNextSwapID(ctx), k.GetParams(ctx), k.GetAllHistory(ctx), k.GetHolders(ctx))
 }
@@ -0,0 +1,150 @@
+package collectables_test
+
+import (
+	"fmt"
+	"testing"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+
+	nft "github.com/tosch110/collectables/x/collectables"
+)
+
+var (
+	alice = sdk.AccAddress([]byte("alice-address-xxxxxx"))
+	bob   = sdk.AccAddress([]byte("bob-address-xxxxxxxx"))
+)
+
+// createTestInput returns a keeper backed by an empty in-memory store at block height 10
+func createTestInput(t *testing.T) (sdk.Context, nft.Keeper, sdk.StoreKey) {
+	ctx, k, keyNFT, _ := newTestInput(t)
+	return ctx, k, keyNFT
+}
+
+// createTestInputWithBank returns a keeper moving coins through the returned bank keeper, with the
+// module account allowed to mint and burn the shares of fractionalized NFTs
+func createTestInputWithBank(t *testing.T) (sdk.Context, nft.Keeper, bank.Keeper) {
+	ctx, k, _, bankKeeper := newTestInput(t)
+	return ctx, k, bankKeeper
+}
+
+func newTestInput(t *testing.T) (sdk.Context, nft.Keeper, sdk.StoreKey, bank.Keeper) {
+	cdc := codec.New()
+	codec.RegisterCrypto(cdc)
+	sdk.RegisterCodec(cdc)
+	auth.RegisterCodec(cdc)
+	supply.RegisterCodec(cdc)
+	nft.RegisterCodec(cdc)
+
+	keyNFT := sdk.NewKVStoreKey(nft.StoreKey)
+	keyAcc := sdk.NewKVStoreKey(auth.StoreKey)
+	keySupply := sdk.NewKVStoreKey(supply.StoreKey)
+	keyParams := sdk.NewKVStoreKey(params.StoreKey)
+	tkeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
+
+	ms := store.NewCommitMultiStore(dbm.NewMemDB())
+	ms.MountStoreWithDB(keyNFT, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(keyAcc, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(keySupply, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, nil)
+	if err := ms.LoadLatestVersion(); err != nil {
+		t.Fatal(err)
+	}
+	ctx := sdk.NewContext(ms, abci.Header{Height: 10}, false, log.NewNopLogger())
+
+	paramsKeeper := params.NewKeeper(cdc, keyParams, tkeyParams)
+	accountKeeper := auth.NewAccountKeeper(cdc, keyAcc, paramsKeeper.Subspace(auth.DefaultParamspace), auth.ProtoBaseAccount)
+	bankKeeper := bank.NewBaseKeeper(accountKeeper, paramsKeeper.Subspace(bank.DefaultParamspace), map[string]bool{})
+	supplyKeeper := supply.NewKeeper(cdc, keySupply, accountKeeper, bankKeeper,
+		map[string][]string{nft.ModuleName: {supply.Minter, supply.Burner}})
+	supplyKeeper.SetSupply(ctx, supply.NewSupply(sdk.NewCoins()))
+
+	k := nft.NewKeeper(cdc, keyNFT, paramsKeeper.Subspace(nft.DefaultParamspace))
+	k.CoinKeeper = bankKeeper
+	k.SupplyKeeper = supplyKeeper
+	return ctx, k, keyNFT, bankKeeper
+}
+
+// checkEscrow fails the test if the coins and NFTs held in escrow don't match the open loans, bundles, swaps and vaults
+func checkEscrow(t *testing.T, ctx sdk.Context, k nft.Keeper) {
+	t.Helper()
+	if msg, broken := nft.EscrowInvariant(k)(ctx); broken {
+		t.Fatal(msg)
+	}
+}
+
+// mint mints an NFT created by its owner with a proof derived from the ID
+func mint(t *testing.T, ctx sdk.Context, k nft.Keeper, denom, id string, owner sdk.AccAddress, traits nft.Traits) {
+	proof := fmt.Sprintf("the proof of the collectable NFT %s", id)
+	token := nft.NewBaseNFT(id, owner, nft.HashProof(proof), proof, id, 0, 0, sdk.NewCoins())
+	token.EditMetadata(id, "", "", traits)
+	token.SetCreator(owner)
+	if err := k.MintNFT(ctx, denom, &token); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestExportImportGenesis(t *testing.T) {
+	ctxA, k, keyA := createTestInput(t)
+	nft.InitGenesis(ctxA, k, nft.DefaultGenesisState())
+
+	red := nft.NewTraits(nft.NewTrait("color", "string", "red"))
+	for _, id := range []string{"a", "b", "c", "d", "e"} {
+		mint(t, ctxA, k, nft.FighterDenom, id, alice, red)
+	}
+	mint(t, ctxA, k, nft.ItemDenom, "sword", alice, nil)
+
+	// transfer, rent, equip and burn so every index and the history are exported
+	token, _ := k.GetNFT(ctxA, nft.FighterDenom, "b")
+	token.SetOwner(bob)
+	if err := k.UpdateNFT(ctxA, nft.FighterDenom, token); err != nil {
+		t.Fatal(err)
+	}
+	token, _ = k.GetNFT(ctxA, nft.FighterDenom, "c")
+	if err := k.RentNFT(ctxA, nft.FighterDenom, token, bob, 20); err != nil {
+		t.Fatal(err)
+	}
+	if err := k.Equip(ctxA, alice, nft.NewNFTRef(nft.ItemDenom, "sword"), nft.NewNFTRef(nft.FighterDenom, "a")); err != nil {
+		t.Fatal(err)
+	}
+	if err := k.DeleteNFT(ctxA, nft.FighterDenom, "e"); err != nil {
+		t.Fatal(err)
+	}
+
+	exported := nft.ExportGenesis(ctxA, k)
+	if err := nft.ValidateGenesis(exported); err != nil {
+		t.Fatal(err)
+	}
+
+	for name, genesis := range map[string]nft.GenesisState{
+		"exported":       exported,
+		"derived owners": func() nft.GenesisState { g := exported; g.Owners = nil; return g }(),
+	} {
+		t.Run(name, func(t *testing.T) {
+			ctxB, kB, keyB := createTestInput(t)
+			nft.InitGenesis(ctxB, kB, genesis)
+
+			failedKVAs, failedKVBs := sdk.DiffKVStores(ctxA.KVStore(keyA), ctxB.KVStore(keyB), nil)
+			for i := range failedKVAs {
+				t.Errorf("store mismatch at %X:\n%X\n%X", failedKVAs[i].Key, failedKVAs[i].Value, failedKVBs[i].Value)
+			}
+
+			if a, b := kB.GetHeldBy(ctxB, bob), k.GetHeldBy(ctxA, bob); fmt.Sprint(a) != fmt.Sprint(b) {
+				t.Errorf("expected bob to have held %v, got %v", b, a)
+			}
+			if found := kB.SearchNFTs(ctxB, nft.FighterDenom, red); len(found) != 1 || found[0].Supply() != 4 {
+				t.Errorf("expected the search to find 4 NFTs, got %v", found)
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package collectables_test
+
+import (
+	"errors"
+	"testing"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	nft "github.com/tosch110/collectables/x/collectables"
+	"github.com/tosch110/collectables/x/collectables/keeper"
+	"github.com/tosch110/collectables/x/collectables/types/v1beta1"
+)
+
+func TestGRPCQuery(t *testing.T) {
+	ctx, k, _ := createTestInput(t)
+	nft.InitGenesis(ctx, k, nft.DefaultGenesisState())
+
+	red := nft.NewTraits(nft.NewTrait("color", "string", "red"))
+	for _, id := range []string{"a", "b"} {
+		mint(t, ctx, k, nft.FighterDenom, id, alice, red)
+	}
+	mint(t, ctx, k, nft.ItemDenom, "sword", bob, nil)
+	c := nft.WrapSDKContext(ctx)
+
+	supply, err := k.Supply(c, &v1beta1.QuerySupplyRequest{Denom: nft.FighterDenom})
+	if err != nil || supply.Amount != 2 {
+		t.Fatalf("expected a supply of 2, got %v %v", supply, err)
+	}
+	if _, err := k.Supply(c, &v1beta1.QuerySupplyRequest{Denom: "unknown"}); !errors.Is(err, nft.ErrUnknownCollection) {
+		t.Fatalf("expected %v, got %v", nft.ErrUnknownCollection, err)
+	}
+
+	owner, err := k.Owner(c, &v1beta1.QueryOwnerRequest{Owner: alice.String()})
+	if err != nil || !owner.Owner.Address.Equals(alice) || len(owner.Owner.IDCollections) != 1 {
+		t.Fatalf("expected alice to own a single collection, got %v %v", owner, err)
+	}
+	byDenom, err := k.OwnerByDenom(c, &v1beta1.QueryOwnerByDenomRequest{Owner: bob.String(), Denom: nft.ItemDenom})
+	if err != nil || len(byDenom.Owner.IDCollections) != 1 || len(byDenom.Owner.IDCollections[0].IDs) != 1 {
+		t.Fatalf("expected bob to own the sword, got %v %v", byDenom, err)
+	}
+
+	denoms, err := k.Denoms(c, &v1beta1.QueryDenomsRequest{})
+	if err != nil || len(denoms.Denoms) != 2 {
+		t.Fatalf("expected 2 denoms, got %v %v", denoms, err)
+	}
+
+	collection, err := k.Collection(c, &v1beta1.QueryCollectionRequest{Denom: nft.FighterDenom})
+	if err != nil {
+		t.Fatal(err)
+	}
+	stored, _ := k.GetCollection(ctx, nft.FighterDenom)
+	if converted, err := nft.CollectionFromProto(collection.Collection); err != nil || converted.String() != stored.String() {
+		t.Fatalf("expected collection\n%s\ngot\n%s %v", stored.String(), converted.String(), err)
+	}
+
+	token, err := k.NFT(c, &v1beta1.QueryNFTRequest{Denom: nft.FighterDenom, ID: "a"})
+	if err != nil || !token.NFT.Owner.Equals(alice) || len(token.NFT.Traits) != 1 || token.NFT.Stats.Rarity == "" {
+		t.Fatalf("expected NFT #a of alice, got %v %v", token, err)
+	}
+	if _, err := k.NFT(c, &v1beta1.QueryNFTRequest{Denom: nft.FighterDenom, ID: "z"}); !errors.Is(err, nft.ErrUnknownNFT) {
+		t.Fatalf("expected %v, got %v", nft.ErrUnknownNFT, err)
+	}
+}
+
+func TestGRPCQueryRoute(t *testing.T) {
+	ctx, k, _ := createTestInput(t)
+	nft.InitGenesis(ctx, k, nft.DefaultGenesisState())
+	mint(t, ctx, k, nft.FighterDenom, "a", alice, nil)
+	querier := nft.NewQuerier(k)
+
+	bz, err := (&v1beta1.QueryNFTRequest{Denom: nft.FighterDenom, ID: "a"}).Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	bz, err = querier(ctx, []string{keeper.QueryGRPC, "NFT"}, abci.RequestQuery{Data: bz})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var token v1beta1.QueryNFTResponse
+	if err := token.Unmarshal(bz); err != nil || token.NFT.ID != "a" || !token.NFT.Owner.Equals(alice) {
+		t.Fatalf("expected NFT #a of alice, got %v %v", token, err)
+	}
+
+	// the errors of the Query service are returned as is
+	bz, _ = (&v1beta1.QuerySupplyRequest{Denom: "unknown"}).Marshal()
+	if _, err := querier(ctx, []string{keeper.QueryGRPC, "Supply"}, abci.RequestQuery{Data: bz}); !errors.Is(err, nft.ErrUnknownCollection) {
+		t.Fatalf("expected %v, got %v", nft.ErrUnknownCollection, err)
+	}
+
+	for _, tc := range []struct {
+		path []string
+		data []byte
+		err  error
+	}{
+		{[]string{keeper.QueryGRPC}, nil, sdkerrors.ErrUnknownRequest},
+		{[]string{keeper.QueryGRPC, "Unknown"}, nil, sdkerrors.ErrUnknownRequest},
+		{[]string{keeper.QueryGRPC, "Denoms"}, []byte("not protobuf"), sdkerrors.ErrInvalidRequest},
+	} {
+		if _, err := querier(ctx, tc.path, abci.RequestQuery{Data: tc.data}); !errors.Is(err, tc.err) {
+			t.Errorf("expected %v for %v, got %v", tc.err, tc.path, err)
+		}
+	}
+}
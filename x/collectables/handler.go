@@ -1,10 +1,7 @@
 package collectables
 
 import (
-	"encoding/hex"
 	"fmt"
-	"lukechampine.com/blake3"
-	"strconv"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 
@@ -33,6 +30,48 @@ func GenericHandler(k keeper.Keeper) sdk.Handler {
 			return HandleMsgBuyNFT(ctx, msg, k)
 		case types.MsgChallengeNFT:
 			return HandleMsgChallengeNFT(ctx, msg, k)
+		case types.MsgCreateCollection:
+			return HandleMsgCreateCollection(ctx, msg, k)
+		case types.MsgFreezeMetadata:
+			return HandleMsgFreezeMetadata(ctx, msg, k)
+		case types.MsgRevealCollection:
+			return HandleMsgRevealCollection(ctx, msg, k)
+		case types.MsgFreezeNFT:
+			return HandleMsgFreezeNFT(ctx, msg, k)
+		case types.MsgUnfreezeNFT:
+			return HandleMsgUnfreezeNFT(ctx, msg, k)
+		case types.MsgClawbackNFT:
+			return HandleMsgClawbackNFT(ctx, msg, k)
+		case types.MsgRentNFT:
+			return HandleMsgRentNFT(ctx, msg, k)
+		case types.MsgRequestLoan:
+			return HandleMsgRequestLoan(ctx, msg, k)
+		case types.MsgCancelLoan:
+			return HandleMsgCancelLoan(ctx, msg, k)
+		case types.MsgFundLoan:
+			return HandleMsgFundLoan(ctx, msg, k)
+		case types.MsgRepayLoan:
+			return HandleMsgRepayLoan(ctx, msg, k)
+		case types.MsgFractionalize:
+			return HandleMsgFractionalize(ctx, msg, k)
+		case types.MsgRedeem:
+			return HandleMsgRedeem(ctx, msg, k)
+		case types.MsgBuyout:
+			return HandleMsgBuyout(ctx, msg, k)
+		case types.MsgWrapNFTs:
+			return HandleMsgWrapNFTs(ctx, msg, k)
+		case types.MsgUnwrapBundle:
+			return HandleMsgUnwrapBundle(ctx, msg, k)
+		case types.MsgProposeSwap:
+			return HandleMsgProposeSwap(ctx, msg, k)
+		case types.MsgAcceptSwap:
+			return HandleMsgAcceptSwap(ctx, msg, k)
+		case types.MsgCancelSwap:
+			return HandleMsgCancelSwap(ctx, msg, k)
+		case types.MsgEquip:
+			return HandleMsgEquip(ctx, msg, k)
+		case types.MsgUnequip:
+			return HandleMsgUnequip(ctx, msg, k)
 		default:
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, fmt.Sprintf("unrecognized nft message type: %T", msg))
 		}
@@ -46,6 +85,12 @@ func HandleMsgSendNFT(ctx sdk.Context, msg types.MsgSendNFT, k keeper.Keeper,
 	if err != nil {
 		return nil, err
 	}
+	if !nft.GetOwner().Equals(msg.Sender) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("%s is not the owner of NFT #%s", msg.Sender, msg.ID))
+	}
+	if err := types.CheckTransferable(nft); err != nil {
+		return nil, err
+	}
 	// update NFT owner
 	nft.SetOwner(msg.Recipient)
 	// update the NFT (owners are updated within the keeper)
@@ -55,12 +100,12 @@ func HandleMsgSendNFT(ctx sdk.Context, msg types.MsgSendNFT, k keeper.Keeper,
 	}
 
 	ctx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			types.EventTypeSend,
-			sdk.NewAttribute(types.AttributeKeyRecipient, msg.Recipient.String()),
-			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
-			sdk.NewAttribute(types.AttributeKeyNFTID, msg.ID),
-		),
+		types.EventTransferNFT{
+			Denom:         msg.Denom,
+			ID:            msg.ID,
+			PreviousOwner: msg.Sender,
+			NewOwner:      msg.Recipient,
+		}.ToEvent(),
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
@@ -73,17 +118,40 @@ func HandleMsgSendNFT(ctx sdk.Context, msg types.MsgSendNFT, k keeper.Keeper,
 // HandleMsgEditNFTMetadata handler for MsgEditNFTMetadata
 func HandleMsgEditNFTMetadata(ctx sdk.Context, msg types.MsgEditNFTMetadata, k keeper.Keeper,
 ) (*sdk.Result, error) {
-	nft, err := k.GetNFT(ctx, msg.Denom, msg.ID)
+	collection, found := k.GetCollection(ctx, msg.Denom)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrUnknownCollection, fmt.Sprintf("collection of %s doesn't exist", msg.Denom))
+	}
+	nft, err := collection.GetNFT(msg.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// only the owner can edit the metadata and traits have to follow the collection schema
+	if !nft.GetOwner().Equals(msg.Sender) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("%s is not the owner of NFT #%s", msg.Sender, msg.ID))
+	}
+	if !collection.IsRevealed() {
+		return nil, sdkerrors.Wrap(types.ErrNotRevealed, fmt.Sprintf("collection %s is not revealed yet", msg.Denom))
+	}
+	if collection.Frozen {
+		return nil, sdkerrors.Wrap(types.ErrMetadataFrozen, fmt.Sprintf("collection %s is frozen", msg.Denom))
+	}
+	if nft.IsFrozen() {
+		return nil, sdkerrors.Wrap(types.ErrMetadataFrozen, fmt.Sprintf("NFT #%s is frozen", msg.ID))
+	}
+	err = collection.Schema.Check(msg.Traits)
 	if err != nil {
 		return nil, err
 	}
 
 	// update NFT
-	nft.EditMetadata(msg.Name)
+	nft.EditMetadata(msg.Name, msg.Description, msg.TokenURI, msg.Traits)
 	err = k.UpdateNFT(ctx, msg.Denom, nft)
 	if err != nil {
 		return nil, err
 	}
+	k.AppendHistory(ctx, msg.Denom, msg.ID, types.NewEditMetadataEntry(msg.Sender))
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
@@ -91,6 +159,9 @@ func HandleMsgEditNFTMetadata(ctx sdk.Context, msg types.MsgEditNFTMetadata, k k
 			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
 			sdk.NewAttribute(types.AttributeKeyNFTID, msg.ID),
 			sdk.NewAttribute(types.AttributeKeyNFTName, msg.Name),
+			sdk.NewAttribute(types.AttributeKeyNFTDescription, msg.Description),
+			sdk.NewAttribute(types.AttributeKeyNFTTokenURI, msg.TokenURI),
+			sdk.NewAttribute(types.AttributeKeyNFTTraits, msg.Traits.String()),
 		),
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
@@ -108,8 +179,18 @@ func HandleMsgEditNFTPrice(ctx sdk.Context, msg types.MsgEditNFTPrice, k keeper.
 	if err != nil {
 		return nil, err
 	}
+	if !nft.GetOwner().Equals(msg.Sender) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("%s is not the owner of NFT #%s", msg.Sender, msg.ID))
+	}
+	// only transferable NFTs can be listed for sale
+	if !msg.Price.IsZero() {
+		if err := types.CheckTransferable(nft); err != nil {
+			return nil, sdkerrors.Wrap(err, "NFT can't be listed")
+		}
+	}
 
 	// update NFT
+	previousPrice := nft.GetPrice()
 	nft.EditPrice(msg.Price)
 	err = k.UpdateNFT(ctx, msg.Denom, nft)
 	if err != nil {
@@ -117,12 +198,13 @@ func HandleMsgEditNFTPrice(ctx sdk.Context, msg types.MsgEditNFTPrice, k keeper.
 	}
 
 	ctx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			types.EventTypeEditNFTPrice,
-			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
-			sdk.NewAttribute(types.AttributeKeyNFTID, msg.ID),
-			sdk.NewAttribute(types.AttributeKeyNFTPrice, msg.Price.String()),
-		),
+		types.EventEditNFTPrice{
+			Denom:         msg.Denom,
+			ID:            msg.ID,
+			Owner:         msg.Sender,
+			PreviousPrice: previousPrice,
+			Price:         msg.Price,
+		}.ToEvent(),
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
@@ -135,30 +217,40 @@ func HandleMsgEditNFTPrice(ctx sdk.Context, msg types.MsgEditNFTPrice, k keeper.
 // HandleMsgMintNFT handles MsgMintNFT
 func HandleMsgMintNFT(ctx sdk.Context, msg types.MsgMintNFT, k keeper.Keeper,
 ) (*sdk.Result, error) {
+	// the proof and hash have to be verified before anything is written to the store
+	proofCheck := types.HashProof(msg.Proof)
+	if msg.Hash != proofCheck {
+		return nil, sdkerrors.Wrap(types.ErrHashMismatch, fmt.Sprintf("expected hash %s", proofCheck))
+	}
+	// a bundle NFT minted without wrapping anything would take the ID of the next bundle
+	if msg.Denom == types.BundleDenom {
+		return nil, sdkerrors.Wrap(types.ErrInvalidCollection, "collection "+types.BundleDenom+" is reserved for bundles")
+	}
+	if collection, found := k.GetCollection(ctx, msg.Denom); found {
+		if err := collection.CheckMinter(msg.Sender); err != nil {
+			return nil, err
+		}
+	}
+
 	nft := types.NewBaseNFT(msg.ID, msg.Recipient, msg.Hash, msg.Proof, msg.Name, 0, 0, msg.Price)
+	nft.SetTransferPolicy(msg.TransferPolicy)
+	nft.SetCreator(msg.Sender)
 	err := k.MintNFT(ctx, msg.Denom, &nft)
 	if err != nil {
 		return nil, err
 	}
 
-	proofCheck := blakeHash(msg.Proof)
-	if msg.Hash != proofCheck {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrInsufficientFunds, "Hash is not the blake3 hash of the Proof") // If not, throw an error
-	}
-
 	ctx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			types.EventTypeMintNFT,
-			sdk.NewAttribute(types.AttributeKeyRecipient, msg.Recipient.String()),
-			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
-			sdk.NewAttribute(types.AttributeKeyNFTID, msg.ID),
-			sdk.NewAttribute(types.AttributeKeyNFTHash, msg.Hash),
-			sdk.NewAttribute(types.AttributeKeyNFTProof, msg.Proof),
-			sdk.NewAttribute(types.AttributeKeyNFTName, msg.Name),
-			sdk.NewAttribute(types.AttributeKeyNFTWins, "0"),
-			sdk.NewAttribute(types.AttributeKeyNFTLosses, "0"),
-			sdk.NewAttribute(types.AttributeKeyNFTPrice, msg.Price.String()),
-		),
+		types.EventMintNFT{
+			Denom:          msg.Denom,
+			ID:             msg.ID,
+			Owner:          msg.Recipient,
+			Hash:           msg.Hash,
+			Proof:          msg.Proof,
+			Name:           msg.Name,
+			Price:          msg.Price,
+			TransferPolicy: nft.GetTransferPolicy(),
+		}.ToEvent(),
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
@@ -171,10 +263,20 @@ func HandleMsgMintNFT(ctx sdk.Context, msg types.MsgMintNFT, k keeper.Keeper,
 // HandleMsgBurnNFT handles MsgBurnNFT
 func HandleMsgBurnNFT(ctx sdk.Context, msg types.MsgBurnNFT, k keeper.Keeper,
 ) (*sdk.Result, error) {
-	_, err := k.GetNFT(ctx, msg.Denom, msg.ID)
+	nft, err := k.GetNFT(ctx, msg.Denom, msg.ID)
 	if err != nil {
 		return nil, err
 	}
+	if !nft.GetOwner().Equals(msg.Sender) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("%s is not the owner of NFT #%s", msg.Sender, msg.ID))
+	}
+	if err := types.CheckBurnable(nft); err != nil {
+		return nil, err
+	}
+	// burning a bundle would leave the NFTs it wraps in escrow forever
+	if msg.Denom == types.BundleDenom {
+		return nil, sdkerrors.Wrap(types.ErrInvalidBundle, fmt.Sprintf("bundle %s has to be unwrapped before burning", msg.ID))
+	}
 
 	// remove  NFT
 	err = k.DeleteNFT(ctx, msg.Denom, msg.ID)
@@ -183,11 +285,11 @@ func HandleMsgBurnNFT(ctx sdk.Context, msg types.MsgBurnNFT, k keeper.Keeper,
 	}
 
 	ctx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			types.EventTypeBurnNFT,
-			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
-			sdk.NewAttribute(types.AttributeKeyNFTID, msg.ID),
-		),
+		types.EventBurnNFT{
+			Denom:         msg.Denom,
+			ID:            msg.ID,
+			PreviousOwner: msg.Sender,
+		}.ToEvent(),
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
@@ -205,6 +307,10 @@ func HandleMsgBuyNFT(ctx sdk.Context, msg types.MsgBuyNFT, k keeper.Keeper,
 		return nil, err
 	}
 
+	if err := types.CheckTransferable(nft); err != nil {
+		return nil, err
+	}
+
 	// Checks if NFT is for sale
 	if nft.GetPrice().IsZero() {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInsufficientFunds, "Token is not for sale.") // If not, throw an error
@@ -216,7 +322,8 @@ func HandleMsgBuyNFT(ctx sdk.Context, msg types.MsgBuyNFT, k keeper.Keeper,
 	}
 
 	// Price matches, send coins
-	err = k.CoinKeeper.SendCoins(ctx, msg.Sender, nft.GetOwner(), msg.Price)
+	seller := nft.GetOwner()
+	err = k.CoinKeeper.SendCoins(ctx, msg.Sender, seller, msg.Price)
 	if err != nil {
 		return nil, err
 	}
@@ -228,14 +335,16 @@ func HandleMsgBuyNFT(ctx sdk.Context, msg types.MsgBuyNFT, k keeper.Keeper,
 	if err != nil {
 		return nil, err
 	}
+	k.AfterSale(ctx, msg.Denom, msg.ID, seller, msg.Sender, msg.Price)
 
 	ctx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			types.EventTypeSend,
-			sdk.NewAttribute(types.AttributeKeySender, msg.Sender.String()),
-			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
-			sdk.NewAttribute(types.AttributeKeyNFTID, msg.ID),
-		),
+		types.EventBuyNFT{
+			Denom:         msg.Denom,
+			ID:            msg.ID,
+			PreviousOwner: seller,
+			NewOwner:      msg.Sender,
+			Price:         msg.Price,
+		}.ToEvent(),
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
@@ -248,6 +357,11 @@ func HandleMsgBuyNFT(ctx sdk.Context, msg types.MsgBuyNFT, k keeper.Keeper,
 // HandleMsgChallengeNFT handler for MsgChallengeNFT
 func HandleMsgChallengeNFT(ctx sdk.Context, msg types.MsgChallengeNFT, k keeper.Keeper,
 ) (*sdk.Result, error) {
+	// both NFTs are read before being written so they must be distinct
+	if msg.ContenderDenom == msg.DefiantDenom && msg.ContenderID == msg.DefiantID {
+		return nil, sdkerrors.Wrap(types.ErrInvalidNFT, fmt.Sprintf("NFT #%s can't challenge itself", msg.ContenderID))
+	}
+
 	contenderNFT, err := k.GetNFT(ctx, msg.ContenderDenom, msg.ContenderID)
 	if err != nil {
 		return nil, err
@@ -258,18 +372,32 @@ func HandleMsgChallengeNFT(ctx sdk.Context, msg types.MsgChallengeNFT, k keeper.
 		return nil, err
 	}
 
-	contendantHash := contenderNFT.GetHash()
-	contendantWins := contenderNFT.GetWins()
+	// the challenger is the owner of the contender or, while it is rented, its current user
+	if !types.CanUse(contenderNFT, msg.Sender) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("%s can't use NFT #%s", msg.Sender, msg.ContenderID))
+	}
+	// the defiant changes hands if it loses so only transferable NFTs can take part
+	if err := types.CheckUsable(contenderNFT); err != nil {
+		return nil, err
+	}
+	if err := types.CheckTransferable(defiantNFT); err != nil {
+		return nil, err
+	}
 
-	defiantHash := defiantNFT.GetHash()
-	defiantWins := defiantNFT.GetWins()
+	contenderStats, err := k.GetEquippedStats(ctx, msg.ContenderDenom, contenderNFT)
+	if err != nil {
+		return nil, err
+	}
 
-	matchResults, err := fight(contendantHash, int(contendantWins), defiantHash, int(defiantWins)) // our match logic. Returns the results for both tokens and the winner
+	defiantStats, err := k.GetEquippedStats(ctx, msg.DefiantDenom, defiantNFT)
 	if err != nil {
 		return nil, err
 	}
 
-	if matchResults.Winner == "contestant" {
+	defiantOwner := defiantNFT.GetOwner()
+	matchResults := fight(contenderStats, contenderNFT.GetWins(), defiantStats, defiantNFT.GetWins()) // our match logic. Returns the results for both tokens and the winner
+
+	if matchResults.Winner == WinnerContestant {
 		// update NFT owner
 		defiantNFT.SetOwner(msg.Sender)
 		// increase loose streak of looser of this match
@@ -278,7 +406,7 @@ func HandleMsgChallengeNFT(ctx sdk.Context, msg types.MsgChallengeNFT, k keeper.
 		contenderNFT.IncreaseWins()
 	}
 
-	if matchResults.Winner == "defiant" {
+	if matchResults.Winner == WinnerDefiant {
 		// increase loose streak of looser of this match
 		contenderNFT.IncreaseLosses()
 		// increase winning streak of winner of this match
@@ -296,15 +424,415 @@ func HandleMsgChallengeNFT(ctx sdk.Context, msg types.MsgChallengeNFT, k keeper.
 		return nil, err
 	}
 
+	contender := types.NewNFTRef(msg.ContenderDenom, msg.ContenderID)
+	defiant := types.NewNFTRef(msg.DefiantDenom, msg.DefiantID)
+	if matchResults.Winner == WinnerContestant {
+		k.AfterChallenge(ctx, contender, defiant)
+	} else {
+		k.AfterChallenge(ctx, defiant, contender)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		types.EventChallengeNFT{
+			Challenger:    msg.Sender,
+			Contender:     contender,
+			Defiant:       defiant,
+			Winner:        matchResults.Winner,
+			PreviousOwner: defiantOwner,
+			NewOwner:      defiantNFT.GetOwner(),
+		}.ToEvent(),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+	})
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// HandleMsgCreateCollection handles MsgCreateCollection
+func HandleMsgCreateCollection(ctx sdk.Context, msg types.MsgCreateCollection, k keeper.Keeper,
+) (*sdk.Result, error) {
+	if _, found := k.GetCollection(ctx, msg.Denom); found {
+		return nil, sdkerrors.Wrap(types.ErrCollectionExists, fmt.Sprintf("collection of %s already exists", msg.Denom))
+	}
+
+	collection := types.NewCreatedCollection(msg.Denom, msg.Sender, msg.Schema)
+	collection.GeneratorVersion = k.LatestGeneratorVersion()
+	collection.Commitment = msg.Commitment
+	collection.TransferPolicy = msg.TransferPolicy.Normalize()
+	collection.Issuer = msg.Issuer
+	collection.IssuerCapabilities = msg.IssuerCapabilities
+	k.SetCollection(ctx, msg.Denom, collection)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCreateCollection,
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeKeyCreator, msg.Sender.String()),
+			sdk.NewAttribute(types.AttributeKeyCommitment, msg.Commitment),
+			sdk.NewAttribute(types.AttributeKeyTransferPolicy, msg.TransferPolicy.String()),
+			sdk.NewAttribute(types.AttributeKeyIssuer, msg.Issuer.String()),
+			sdk.NewAttribute(types.AttributeKeyIssuerCapabilities, msg.IssuerCapabilities.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+	})
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// HandleMsgFreezeMetadata handles MsgFreezeMetadata. The creator of a collection can freeze
+// all of its NFTs at once and the owner of an NFT can freeze that single NFT. Freezing can't
+// be undone, so freezing twice is rejected.
+func HandleMsgFreezeMetadata(ctx sdk.Context, msg types.MsgFreezeMetadata, k keeper.Keeper,
+) (*sdk.Result, error) {
+	collection, found := k.GetCollection(ctx, msg.Denom)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrUnknownCollection, fmt.Sprintf("collection of %s doesn't exist", msg.Denom))
+	}
+	if collection.Frozen {
+		return nil, sdkerrors.Wrap(types.ErrMetadataFrozen, fmt.Sprintf("collection %s is already frozen", msg.Denom))
+	}
+	// the placeholder metadata is replaced by the reveal so it can't be frozen
+	if !collection.IsRevealed() {
+		return nil, sdkerrors.Wrap(types.ErrNotRevealed, fmt.Sprintf("collection %s is not revealed yet", msg.Denom))
+	}
+
+	if msg.ID == "" {
+		if collection.Creator.Empty() || !collection.Creator.Equals(msg.Sender) {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("%s is not the creator of collection %s", msg.Sender, msg.Denom))
+		}
+		collection.Frozen = true
+		k.SetCollection(ctx, msg.Denom, collection)
+	} else {
+		nft, err := collection.GetNFT(msg.ID)
+		if err != nil {
+			return nil, err
+		}
+		if !nft.GetOwner().Equals(msg.Sender) {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("%s is not the owner of NFT #%s", msg.Sender, msg.ID))
+		}
+		if nft.IsFrozen() {
+			return nil, sdkerrors.Wrap(types.ErrMetadataFrozen, fmt.Sprintf("NFT #%s is already frozen", msg.ID))
+		}
+		nft.Freeze()
+		err = k.UpdateNFT(ctx, msg.Denom, nft)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeFreezeMetadata,
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeKeyNFTID, msg.ID),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+	})
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// HandleMsgRevealCollection handles MsgRevealCollection, only the creator of a collection can reveal it
+func HandleMsgRevealCollection(ctx sdk.Context, msg types.MsgRevealCollection, k keeper.Keeper,
+) (*sdk.Result, error) {
+	collection, found := k.GetCollection(ctx, msg.Denom)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrUnknownCollection, fmt.Sprintf("collection of %s doesn't exist", msg.Denom))
+	}
+	if collection.Creator.Empty() || !collection.Creator.Equals(msg.Sender) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("%s is not the creator of collection %s", msg.Sender, msg.Denom))
+	}
+
+	err := k.RevealCollection(ctx, msg.Denom, msg.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRevealCollection,
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeKeyCommitment, collection.Commitment),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+	})
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// HandleMsgFreezeNFT handles MsgFreezeNFT, only an issuer with the freeze capability can freeze an NFT
+func HandleMsgFreezeNFT(ctx sdk.Context, msg types.MsgFreezeNFT, k keeper.Keeper,
+) (*sdk.Result, error) {
+	return setIssuerFrozen(ctx, k, msg.Sender, msg.Denom, msg.ID, true, types.EventTypeFreezeNFT)
+}
+
+// HandleMsgUnfreezeNFT handles MsgUnfreezeNFT, only an issuer with the freeze capability can unfreeze an NFT
+func HandleMsgUnfreezeNFT(ctx sdk.Context, msg types.MsgUnfreezeNFT, k keeper.Keeper,
+) (*sdk.Result, error) {
+	return setIssuerFrozen(ctx, k, msg.Sender, msg.Denom, msg.ID, false, types.EventTypeUnfreezeNFT)
+}
+
+func setIssuerFrozen(ctx sdk.Context, k keeper.Keeper, sender sdk.AccAddress, denom, id string, frozen bool, eventType string,
+) (*sdk.Result, error) {
+	collection, found := k.GetCollection(ctx, denom)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrUnknownCollection, fmt.Sprintf("collection of %s doesn't exist", denom))
+	}
+	err := collection.CheckIssuer(sender, types.IssuerCapabilityFreeze)
+	if err != nil {
+		return nil, err
+	}
+	nft, err := collection.GetNFT(id)
+	if err != nil {
+		return nil, err
+	}
+	if nft.IsIssuerFrozen() == frozen {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("NFT #%s is already in the requested state", id))
+	}
+
+	nft.SetIssuerFrozen(frozen)
+	err = k.UpdateNFT(ctx, denom, nft)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyNFTID, id),
+			sdk.NewAttribute(types.AttributeKeyIssuer, sender.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+		),
+	})
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// HandleMsgClawbackNFT handles MsgClawbackNFT. An issuer with the clawback capability can move
+// any NFT of the collection, whatever its transfer policy or freeze, and its sale listing is removed.
+func HandleMsgClawbackNFT(ctx sdk.Context, msg types.MsgClawbackNFT, k keeper.Keeper,
+) (*sdk.Result, error) {
+	collection, found := k.GetCollection(ctx, msg.Denom)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrUnknownCollection, fmt.Sprintf("collection of %s doesn't exist", msg.Denom))
+	}
+	err := collection.CheckIssuer(msg.Sender, types.IssuerCapabilityClawback)
+	if err != nil {
+		return nil, err
+	}
+	nft, err := collection.GetNFT(msg.ID)
+	if err != nil {
+		return nil, err
+	}
+	owner := nft.GetOwner()
+	if owner.Equals(types.EscrowAddress) {
+		return nil, sdkerrors.Wrap(types.ErrEscrowed, fmt.Sprintf("NFT #%s is locked by the module", msg.ID))
+	}
+
+	// the rental ends with the ownership
+	err = k.EndRental(ctx, msg.Denom, nft)
+	if err != nil {
+		return nil, err
+	}
+	nft.SetOwner(msg.Recipient)
+	nft.EditPrice(sdk.NewCoins())
+	err = k.UpdateNFT(ctx, msg.Denom, nft)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeClawbackNFT,
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeKeyNFTID, msg.ID),
+			sdk.NewAttribute(types.AttributeKeyOwner, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, msg.Recipient.String()),
+			sdk.NewAttribute(types.AttributeKeyIssuer, msg.Sender.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+	})
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// HandleMsgRentNFT handles MsgRentNFT, the owner keeps the NFT and the renter can challenge with it
+// until the rental expires in the EndBlocker
+func HandleMsgRentNFT(ctx sdk.Context, msg types.MsgRentNFT, k keeper.Keeper,
+) (*sdk.Result, error) {
+	nft, err := k.GetNFT(ctx, msg.Denom, msg.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !nft.GetOwner().Equals(msg.Sender) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("%s is not the owner of NFT #%s", msg.Sender, msg.ID))
+	}
+	if err := types.CheckUsable(nft); err != nil {
+		return nil, err
+	}
+
+	if !msg.Fee.IsZero() {
+		err = k.CoinKeeper.SendCoins(ctx, msg.Renter, msg.Sender, msg.Fee)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	expires := ctx.BlockHeight() + msg.Duration
+	err = k.RentNFT(ctx, msg.Denom, nft, msg.Renter, expires)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRentNFT,
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeKeyNFTID, msg.ID),
+			sdk.NewAttribute(types.AttributeKeyUser, msg.Renter.String()),
+			sdk.NewAttribute(types.AttributeKeyUserExpires, fmt.Sprintf("%d", expires)),
+			sdk.NewAttribute(types.AttributeKeyFee, msg.Fee.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+	})
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// HandleMsgRequestLoan handles MsgRequestLoan, the NFT stays in escrow until the loan is closed
+func HandleMsgRequestLoan(ctx sdk.Context, msg types.MsgRequestLoan, k keeper.Keeper,
+) (*sdk.Result, error) {
+	loan, err := k.RequestLoan(ctx, msg.Sender, msg.Denom, msg.ID, msg.Principal, msg.Interest, msg.Duration)
+	if err != nil {
+		return nil, err
+	}
+
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
-			types.EventTypeChallengeNFT,
-			sdk.NewAttribute(types.AttributeKeySender, msg.Sender.String()),
-			sdk.NewAttribute(types.AttributeKeyDenom, msg.ContenderDenom),
-			sdk.NewAttribute(types.AttributeKeyNFTID, msg.ContenderID),
-			sdk.NewAttribute(types.AttributeKeyDenom, msg.DefiantDenom),
-			sdk.NewAttribute(types.AttributeKeyNFTID, msg.DefiantID),
-			sdk.NewAttribute(types.AttributeKeyNFTWinner, msg.Winner),
+			types.EventTypeRequestLoan,
+			sdk.NewAttribute(types.AttributeKeyLoanID, fmt.Sprintf("%d", loan.ID)),
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeKeyNFTID, msg.ID),
+			sdk.NewAttribute(types.AttributeKeyBorrower, msg.Sender.String()),
+			sdk.NewAttribute(types.AttributeKeyPrincipal, msg.Principal.String()),
+			sdk.NewAttribute(types.AttributeKeyInterest, msg.Interest.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+	})
+	return &sdk.Result{Data: sdk.Uint64ToBigEndian(loan.ID), Events: ctx.EventManager().Events()}, nil
+}
+
+// HandleMsgCancelLoan handles MsgCancelLoan, only the borrower can cancel a loan request
+func HandleMsgCancelLoan(ctx sdk.Context, msg types.MsgCancelLoan, k keeper.Keeper,
+) (*sdk.Result, error) {
+	loan, found := k.GetLoan(ctx, msg.LoanID)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrUnknownLoan, fmt.Sprintf("loan %d doesn't exist", msg.LoanID))
+	}
+	if !loan.Borrower.Equals(msg.Sender) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("%s is not the borrower of loan %d", msg.Sender, msg.LoanID))
+	}
+
+	err := k.CancelLoan(ctx, loan)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCancelLoan,
+			sdk.NewAttribute(types.AttributeKeyLoanID, fmt.Sprintf("%d", loan.ID)),
+			sdk.NewAttribute(types.AttributeKeyDenom, loan.Denom),
+			sdk.NewAttribute(types.AttributeKeyNFTID, loan.NFTID),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+	})
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// HandleMsgFundLoan handles MsgFundLoan, the sender lends the principal and becomes the lender
+func HandleMsgFundLoan(ctx sdk.Context, msg types.MsgFundLoan, k keeper.Keeper,
+) (*sdk.Result, error) {
+	loan, found := k.GetLoan(ctx, msg.LoanID)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrUnknownLoan, fmt.Sprintf("loan %d doesn't exist", msg.LoanID))
+	}
+	if loan.Borrower.Equals(msg.Sender) {
+		return nil, sdkerrors.Wrap(types.ErrInvalidLoan, "borrower can't fund its own loan")
+	}
+
+	loan, err := k.FundLoan(ctx, loan, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeFundLoan,
+			sdk.NewAttribute(types.AttributeKeyLoanID, fmt.Sprintf("%d", loan.ID)),
+			sdk.NewAttribute(types.AttributeKeyLender, msg.Sender.String()),
+			sdk.NewAttribute(types.AttributeKeyDueHeight, fmt.Sprintf("%d", loan.DueHeight)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+	})
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// HandleMsgRepayLoan handles MsgRepayLoan, only the borrower can repay a loan to get the collateral back
+func HandleMsgRepayLoan(ctx sdk.Context, msg types.MsgRepayLoan, k keeper.Keeper,
+) (*sdk.Result, error) {
+	loan, found := k.GetLoan(ctx, msg.LoanID)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrUnknownLoan, fmt.Sprintf("loan %d doesn't exist", msg.LoanID))
+	}
+	if !loan.Borrower.Equals(msg.Sender) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("%s is not the borrower of loan %d", msg.Sender, msg.LoanID))
+	}
+
+	err := k.RepayLoan(ctx, loan)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRepayLoan,
+			sdk.NewAttribute(types.AttributeKeyLoanID, fmt.Sprintf("%d", loan.ID)),
+			sdk.NewAttribute(types.AttributeKeyLender, loan.Lender.String()),
+			sdk.NewAttribute(types.AttributeKeyNFTPrice, loan.Repayment().String()),
 		),
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
@@ -317,75 +845,324 @@ func HandleMsgChallengeNFT(ctx sdk.Context, msg types.MsgChallengeNFT, k keeper.
 
 // EndBlocker is run at the end of the block
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) []abci.ValidatorUpdate {
+	k.EndExpiredRentals(ctx)
+	k.LiquidateDueLoans(ctx)
 	return nil
 }
 
-func blakeHash(proof string) string {
-	hash := blake3.New(32, nil)
-	hash.Write([]byte(proof))
-	output := toHex(hash.Sum(nil))
+// Possible winners of a fight
+const (
+	WinnerContestant = "contestant"
+	WinnerDefiant    = "defiant"
+)
 
-	return output
+// Fight struct for the fight information
+type Fight struct {
+	ContestantSum int    `json:"contestant_sum"`
+	DefiantSum    int    `json:"defiant_sum"`
+	Winner        string `json:"winner"`
 }
 
-func toHex(data []byte) string { return hex.EncodeToString(data) }
+// fight resolves a challenge: the contestant attacks with its attack and the defiant
+// blocks with its defense, both adding half their speed and their wins. In case of
+// a draw, the defiant wins.
+func fight(contestant types.Stats, contestantWins uint, defiant types.Stats, defiantWins uint) Fight {
+	contestantSum := int(contestant.Attack + contestant.Speed/2 + contestantWins)
+	defiantSum := int(defiant.Defense + defiant.Speed/2 + defiantWins)
 
-// Fight struct for the fight information
-type Fight struct {
-	ContestantSum  int    `json:"contestant_sum"`
-	ContestantHash string `json:"contestant_hash"`
-	DefiantSum     int    `json:"defiant_sum"`
-	DefiantHash    string `json:"defiant_hash"`
-	Winner         string `json:"winner"`
+	winner := WinnerDefiant
+	if contestantSum > defiantSum {
+		winner = WinnerContestant
+	}
+
+	return Fight{
+		ContestantSum: contestantSum,
+		DefiantSum:    defiantSum,
+		Winner:        winner,
+	}
 }
 
-func fight(contestantHash string, contestantWins int, defiantHash string, defiantWins int) (Fight, error) {
+// HandleMsgFractionalize handler for MsgFractionalize
+func HandleMsgFractionalize(ctx sdk.Context, msg types.MsgFractionalize, k keeper.Keeper,
+) (*sdk.Result, error) {
+	vault, err := k.Fractionalize(ctx, msg.Sender, msg.Denom, msg.ID, msg.Shares, msg.ReservePrice)
+	if err != nil {
+		return nil, err
+	}
 
-	var contestantSum int
-	var defiantSum int
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeFractionalize,
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeKeyNFTID, msg.ID),
+			sdk.NewAttribute(types.AttributeKeyShareDenom, vault.ShareDenom),
+			sdk.NewAttribute(types.AttributeKeyShares, vault.Shares.String()),
+			sdk.NewAttribute(types.AttributeKeyReservePrice, vault.ReservePrice.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+	})
+	return &sdk.Result{Data: []byte(vault.ShareDenom), Events: ctx.EventManager().Events()}, nil
+}
 
-	contestantSum = contestantWins
-	defiantSum = defiantWins
+// HandleMsgRedeem handler for MsgRedeem
+func HandleMsgRedeem(ctx sdk.Context, msg types.MsgRedeem, k keeper.Keeper,
+) (*sdk.Result, error) {
+	vault, found := k.GetVault(ctx, msg.Denom, msg.ID)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrUnknownVault, fmt.Sprintf("NFT #%s of %s isn't fractionalized", msg.ID, msg.Denom))
+	}
+	redeemed, payout, err := k.Redeem(ctx, msg.Sender, vault)
+	if err != nil {
+		return nil, err
+	}
 
-	var result Fight
-	for _, s := range contestantHash {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRedeem,
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeKeyNFTID, msg.ID),
+			sdk.NewAttribute(types.AttributeKeyShareDenom, vault.ShareDenom),
+			sdk.NewAttribute(types.AttributeKeyShares, vault.Shares.Sub(redeemed.Shares).String()),
+			sdk.NewAttribute(types.AttributeKeyPayout, payout.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+	})
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
 
-		charValue := fmt.Sprintf("%d", s)
+// HandleMsgBuyout handler for MsgBuyout
+func HandleMsgBuyout(ctx sdk.Context, msg types.MsgBuyout, k keeper.Keeper,
+) (*sdk.Result, error) {
+	vault, found := k.GetVault(ctx, msg.Denom, msg.ID)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrUnknownVault, fmt.Sprintf("NFT #%s of %s isn't fractionalized", msg.ID, msg.Denom))
+	}
+	vault, err := k.Buyout(ctx, msg.Sender, vault)
+	if err != nil {
+		return nil, err
+	}
 
-		runeValue, err := strconv.Atoi(charValue)
-		if err != nil {
-			return result, err
-		}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeBuyout,
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeKeyNFTID, msg.ID),
+			sdk.NewAttribute(types.AttributeKeyShareDenom, vault.ShareDenom),
+			sdk.NewAttribute(types.AttributeKeyNFTPrice, vault.ReservePrice.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, msg.Sender.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+	})
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
 
-		contestantSum = contestantSum + runeValue
+// HandleMsgWrapNFTs handler for MsgWrapNFTs
+func HandleMsgWrapNFTs(ctx sdk.Context, msg types.MsgWrapNFTs, k keeper.Keeper,
+) (*sdk.Result, error) {
+	bundle, err := k.WrapNFTs(ctx, msg.Sender, msg.Components)
+	if err != nil {
+		return nil, err
 	}
 
-	for _, s := range defiantHash {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeWrapNFTs,
+			sdk.NewAttribute(types.AttributeKeyDenom, types.BundleDenom),
+			sdk.NewAttribute(types.AttributeKeyNFTID, bundle.ID),
+			sdk.NewAttribute(types.AttributeKeyComponents, types.NFTRefs(bundle.Components).String()),
+			sdk.NewAttribute(types.AttributeKeyOwner, msg.Sender.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+	})
+	return &sdk.Result{Data: []byte(bundle.ID), Events: ctx.EventManager().Events()}, nil
+}
 
-		charValue := fmt.Sprintf("%d", s)
+// HandleMsgUnwrapBundle handler for MsgUnwrapBundle
+func HandleMsgUnwrapBundle(ctx sdk.Context, msg types.MsgUnwrapBundle, k keeper.Keeper,
+) (*sdk.Result, error) {
+	bundle, err := k.UnwrapBundle(ctx, msg.Sender, msg.ID)
+	if err != nil {
+		return nil, err
+	}
 
-		runeValue, err := strconv.Atoi(charValue)
-		if err != nil {
-			return result, err
-		}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUnwrapBundle,
+			sdk.NewAttribute(types.AttributeKeyDenom, types.BundleDenom),
+			sdk.NewAttribute(types.AttributeKeyNFTID, bundle.ID),
+			sdk.NewAttribute(types.AttributeKeyComponents, types.NFTRefs(bundle.Components).String()),
+			sdk.NewAttribute(types.AttributeKeyOwner, msg.Sender.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+	})
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// HandleMsgProposeSwap handler for MsgProposeSwap
+func HandleMsgProposeSwap(ctx sdk.Context, msg types.MsgProposeSwap, k keeper.Keeper,
+) (*sdk.Result, error) {
+	swap, err := k.ProposeSwap(ctx, msg.Sender, msg.Counterparty,
+		msg.OfferedNFTs, msg.OfferedCoins, msg.RequestedNFTs, msg.RequestedCoins)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeProposeSwap,
+			sdk.NewAttribute(types.AttributeKeySwapID, fmt.Sprintf("%d", swap.ID)),
+			sdk.NewAttribute(types.AttributeKeyProposer, swap.Proposer.String()),
+			sdk.NewAttribute(types.AttributeKeyCounterparty, swap.Counterparty.String()),
+			sdk.NewAttribute(types.AttributeKeyOfferedNFTs, types.NFTRefs(swap.OfferedNFTs).String()),
+			sdk.NewAttribute(types.AttributeKeyOfferedCoins, swap.OfferedCoins.String()),
+			sdk.NewAttribute(types.AttributeKeyRequestedNFTs, types.NFTRefs(swap.RequestedNFTs).String()),
+			sdk.NewAttribute(types.AttributeKeyRequestedCoins, swap.RequestedCoins.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+	})
+	return &sdk.Result{Data: sdk.Uint64ToBigEndian(swap.ID), Events: ctx.EventManager().Events()}, nil
+}
+
+// HandleMsgAcceptSwap handler for MsgAcceptSwap
+func HandleMsgAcceptSwap(ctx sdk.Context, msg types.MsgAcceptSwap, k keeper.Keeper,
+) (*sdk.Result, error) {
+	swap, found := k.GetSwap(ctx, msg.SwapID)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrUnknownSwap, fmt.Sprintf("swap %d doesn't exist", msg.SwapID))
+	}
+	if !swap.Counterparty.Equals(msg.Sender) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("swap %d isn't addressed to %s", swap.ID, msg.Sender))
+	}
+	err := k.AcceptSwap(ctx, swap)
+	if err != nil {
+		return nil, err
+	}
 
-		defiantSum = defiantSum + runeValue
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeAcceptSwap,
+			sdk.NewAttribute(types.AttributeKeySwapID, fmt.Sprintf("%d", swap.ID)),
+			sdk.NewAttribute(types.AttributeKeyProposer, swap.Proposer.String()),
+			sdk.NewAttribute(types.AttributeKeyCounterparty, swap.Counterparty.String()),
+			sdk.NewAttribute(types.AttributeKeyOfferedNFTs, types.NFTRefs(swap.OfferedNFTs).String()),
+			sdk.NewAttribute(types.AttributeKeyOfferedCoins, swap.OfferedCoins.String()),
+			sdk.NewAttribute(types.AttributeKeyRequestedNFTs, types.NFTRefs(swap.RequestedNFTs).String()),
+			sdk.NewAttribute(types.AttributeKeyRequestedCoins, swap.RequestedCoins.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+	})
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// HandleMsgCancelSwap handler for MsgCancelSwap
+func HandleMsgCancelSwap(ctx sdk.Context, msg types.MsgCancelSwap, k keeper.Keeper,
+) (*sdk.Result, error) {
+	swap, found := k.GetSwap(ctx, msg.SwapID)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrUnknownSwap, fmt.Sprintf("swap %d doesn't exist", msg.SwapID))
+	}
+	if !swap.Proposer.Equals(msg.Sender) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("%s is not the proposer of swap %d", msg.Sender, swap.ID))
+	}
+	err := k.CancelSwap(ctx, swap)
+	if err != nil {
+		return nil, err
 	}
 
-	contestorWins := contestantSum - defiantSum
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCancelSwap,
+			sdk.NewAttribute(types.AttributeKeySwapID, fmt.Sprintf("%d", swap.ID)),
+			sdk.NewAttribute(types.AttributeKeyProposer, swap.Proposer.String()),
+			sdk.NewAttribute(types.AttributeKeyCounterparty, swap.Counterparty.String()),
+			sdk.NewAttribute(types.AttributeKeyOfferedNFTs, types.NFTRefs(swap.OfferedNFTs).String()),
+			sdk.NewAttribute(types.AttributeKeyOfferedCoins, swap.OfferedCoins.String()),
+			sdk.NewAttribute(types.AttributeKeyRequestedNFTs, types.NFTRefs(swap.RequestedNFTs).String()),
+			sdk.NewAttribute(types.AttributeKeyRequestedCoins, swap.RequestedCoins.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+	})
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
 
-	winner := "defiant"
-	if contestorWins > 0 {
-		winner = "contestor"
+// HandleMsgEquip handler for MsgEquip
+func HandleMsgEquip(ctx sdk.Context, msg types.MsgEquip, k keeper.Keeper,
+) (*sdk.Result, error) {
+	err := k.Equip(ctx, msg.Sender, types.NewNFTRef(msg.Denom, msg.ID), types.NewNFTRef(msg.ParentDenom, msg.ParentID))
+	if err != nil {
+		return nil, err
 	}
 
-	result = Fight{
-		ContestantHash: contestantHash,
-		ContestantSum:  contestantSum,
-		DefiantHash:    defiantHash,
-		DefiantSum:     defiantSum,
-		Winner:         winner,
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeEquip,
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeKeyNFTID, msg.ID),
+			sdk.NewAttribute(types.AttributeKeyParentDenom, msg.ParentDenom),
+			sdk.NewAttribute(types.AttributeKeyParentID, msg.ParentID),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+	})
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// HandleMsgUnequip handler for MsgUnequip
+func HandleMsgUnequip(ctx sdk.Context, msg types.MsgUnequip, k keeper.Keeper,
+) (*sdk.Result, error) {
+	parent, err := k.Unequip(ctx, msg.Sender, types.NewNFTRef(msg.Denom, msg.ID))
+	if err != nil {
+		return nil, err
 	}
 
-	return result, nil
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUnequip,
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeKeyNFTID, msg.ID),
+			sdk.NewAttribute(types.AttributeKeyParentDenom, parent.Denom),
+			sdk.NewAttribute(types.AttributeKeyParentID, parent.ID),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+	})
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
@@ -0,0 +1,123 @@
+package collectables_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	nft "github.com/tosch110/collectables/x/collectables"
+)
+
+var carol = sdk.AccAddress([]byte("carol-address-xxxxxx"))
+
+// newMsgMintNFT returns the mint of an NFT sent by the sender to itself, with a proof derived from the ID
+func newMsgMintNFT(sender sdk.AccAddress, denom, id string) nft.MsgMintNFT {
+	proof := fmt.Sprintf("the proof of the collectable NFT %s", id)
+	return nft.NewMsgMintNFT(sender, sender, id, denom, nft.HashProof(proof), proof, id, sdk.NewCoins(), "")
+}
+
+func TestHandleMsgMintNFTIssuerCollection(t *testing.T) {
+	ctx, k, _ := createTestInput(t)
+	nft.InitGenesis(ctx, k, nft.DefaultGenesisState())
+	handler := nft.GenericHandler(k)
+
+	create := nft.NewMsgCreateCollection(alice, "regulated", nil, "", "", carol,
+		nft.IssuerCapabilities{nft.IssuerCapabilityFreeze, nft.IssuerCapabilityClawback})
+	if _, err := handler(ctx, create); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		sender sdk.AccAddress
+		err    error
+	}{
+		{bob, sdkerrors.ErrUnauthorized},
+		{alice, nil},
+		{carol, nil},
+	} {
+		_, err := handler(ctx, newMsgMintNFT(tc.sender, "regulated", tc.sender.String()))
+		if !errors.Is(err, tc.err) || (tc.err == nil && err != nil) {
+			t.Errorf("expected the mint of %s to return %v, got %v", tc.sender, tc.err, err)
+		}
+	}
+	if collection, _ := k.GetCollection(ctx, "regulated"); collection.Supply() != 2 {
+		t.Errorf("expected 2 NFTs minted by the creator and the issuer, got %d", collection.Supply())
+	}
+
+	// anyone can still mint into a collection created by a mint
+	if _, err := handler(ctx, newMsgMintNFT(bob, nft.FighterDenom, "a")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := handler(ctx, newMsgMintNFT(carol, nft.FighterDenom, "b")); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestHandleMsgMintNFTBadgeCollection(t *testing.T) {
+	ctx, k, _ := createTestInput(t)
+	nft.InitGenesis(ctx, k, nft.DefaultGenesisState())
+	handler := nft.GenericHandler(k)
+
+	create := nft.NewMsgCreateCollection(alice, "badges", nil, "", nft.TransferPolicySoulbound, nil, nil)
+	if _, err := handler(ctx, create); err != nil {
+		t.Fatal(err)
+	}
+
+	// a third party can't grant itself a badge of someone else's collection
+	if _, err := handler(ctx, newMsgMintNFT(bob, "badges", "forged")); !errors.Is(err, sdkerrors.ErrUnauthorized) {
+		t.Fatalf("expected %v, got %v", sdkerrors.ErrUnauthorized, err)
+	}
+
+	// the creator grants a badge, which its holder can't pass on
+	grant := newMsgMintNFT(alice, "badges", "granted")
+	grant.Recipient = bob
+	if _, err := handler(ctx, grant); err != nil {
+		t.Fatal(err)
+	}
+	badge, err := k.GetNFT(ctx, "badges", "granted")
+	if err != nil || !badge.GetOwner().Equals(bob) || badge.GetTransferPolicy() != nft.TransferPolicySoulbound {
+		t.Fatalf("expected a soulbound badge held by bob, got %v %v", badge, err)
+	}
+	if _, err := handler(ctx, nft.NewMsgSendNFT(bob, carol, "badges", "granted")); err == nil {
+		t.Fatal("expected the transfer of a soulbound badge to fail")
+	}
+}
+
+func TestHandleMsgMintNFTDelayedReveal(t *testing.T) {
+	ctx, k, _ := createTestInput(t)
+	nft.InitGenesis(ctx, k, nft.DefaultGenesisState())
+	handler := nft.GenericHandler(k)
+
+	metadata := nft.RevealMetadata{nft.NewNFTMetadata("committed", "Final", "the final metadata", "", nil)}
+	create := nft.NewMsgCreateCollection(alice, "hidden", nil, metadata.Commitment(), "", carol,
+		nft.IssuerCapabilities{nft.IssuerCapabilityFreeze})
+	if _, err := handler(ctx, create); err != nil {
+		t.Fatal(err)
+	}
+
+	// nobody but the creator, not even the issuer, can take a committed ID before the reveal
+	for _, sender := range []sdk.AccAddress{bob, carol} {
+		if _, err := handler(ctx, newMsgMintNFT(sender, "hidden", "committed")); !errors.Is(err, sdkerrors.ErrUnauthorized) {
+			t.Fatalf("expected the mint of %s to return %v, got %v", sender, sdkerrors.ErrUnauthorized, err)
+		}
+	}
+	if _, err := handler(ctx, newMsgMintNFT(alice, "hidden", "committed")); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := handler(ctx, nft.NewMsgRevealCollection(alice, "hidden", metadata)); err != nil {
+		t.Fatal(err)
+	}
+	revealed, _ := k.GetNFT(ctx, "hidden", "committed")
+	if !revealed.GetOwner().Equals(alice) || revealed.GetName() != "Final" {
+		t.Fatalf("expected the final metadata on the NFT of the creator, got %v", revealed)
+	}
+
+	// once revealed, the issuer can mint again
+	if _, err := handler(ctx, newMsgMintNFT(carol, "hidden", "later")); err != nil {
+		t.Fatal(err)
+	}
+}
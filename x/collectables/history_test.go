@@ -0,0 +1,82 @@
+package collectables_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	nft "github.com/tosch110/collectables/x/collectables"
+)
+
+// transfer gives an NFT to a new owner, which records the transfer in its history
+func transfer(t *testing.T, ctx sdk.Context, k nft.Keeper, denom, id string, owner sdk.AccAddress) {
+	token, err := k.GetNFT(ctx, denom, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	token.SetOwner(owner)
+	if err := k.UpdateNFT(ctx, denom, token); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestHistoryLimit(t *testing.T) {
+	ctx, k, _ := createTestInput(t)
+	nft.InitGenesis(ctx, k, nft.DefaultGenesisState())
+	k.SetParams(ctx, nft.NewParams(3))
+
+	// the mint and the first two transfers fill the history, the oldest entries make room for the next ones
+	mint(t, ctx, k, nft.FighterDenom, "a", alice, nil)
+	for i, owner := range []sdk.AccAddress{bob, alice, bob, alice} {
+		transfer(t, ctx, k, nft.FighterDenom, "a", owner)
+
+		history := k.GetHistory(ctx, nft.FighterDenom, "a")
+		expected := i + 2
+		if expected > 3 {
+			expected = 3
+		}
+		if len(history) != expected {
+			t.Fatalf("expected %d entries, got %d", expected, len(history))
+		}
+		if last := history[len(history)-1]; last.Sequence != uint64(i+2) || !last.To.Equals(owner) {
+			t.Fatalf("expected the transfer to %s to be the newest entry, got %v", owner, last)
+		}
+		if first := history[0]; first.Sequence != uint64(i+3-expected) {
+			t.Fatalf("expected the oldest entry to be #%d, got #%d", i+3-expected, first.Sequence)
+		}
+	}
+
+	// a limit of 0 stops recording, and the history left is pruned the next time the NFT changes
+	k.SetParams(ctx, nft.NewParams(0))
+	if history := k.GetHistory(ctx, nft.FighterDenom, "a"); len(history) != 3 {
+		t.Fatalf("expected the history to be kept until the NFT changes, got %d entries", len(history))
+	}
+	transfer(t, ctx, k, nft.FighterDenom, "a", bob)
+	if history := k.GetHistory(ctx, nft.FighterDenom, "a"); len(history) != 0 {
+		t.Fatalf("expected the history to be pruned, got %v", history)
+	}
+
+	// recording starts over once the limit is raised
+	k.SetParams(ctx, nft.NewParams(3))
+	transfer(t, ctx, k, nft.FighterDenom, "a", alice)
+	if history := k.GetHistory(ctx, nft.FighterDenom, "a"); len(history) != 1 || !history[0].To.Equals(alice) {
+		t.Fatalf("expected the history to hold the last transfer, got %v", history)
+	}
+}
+
+func TestHistoryLimitValidation(t *testing.T) {
+	for _, tc := range []struct {
+		limit uint64
+		valid bool
+	}{
+		{0, true},
+		{nft.DefaultHistoryLimit, true},
+		{nft.MaxHistoryLimit, true},
+		{nft.MaxHistoryLimit + 1, false},
+		{1<<64 - 1, false},
+	} {
+		if err := nft.NewParams(tc.limit).Validate(); (err == nil) != tc.valid {
+			t.Errorf("expected a history limit of %d to be valid: %t, got %v", tc.limit, tc.valid, err)
+		}
+	}
+}
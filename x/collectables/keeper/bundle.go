@@ -0,0 +1,157 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/tosch110/collectables/x/collectables/types"
+)
+
+// GetNextBundleID returns the ID the next bundle will get
+func (k Keeper) GetNextBundleID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.NextBundleIDKey)
+	if bz == nil {
+		return 1
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetNextBundleID sets the ID the next bundle will get
+func (k Keeper) SetNextBundleID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.NextBundleIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetBundle returns a bundle from the ID of its NFT
+func (k Keeper) GetBundle(ctx sdk.Context, id string) (bundle types.Bundle, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetBundleKey(id))
+	if bz == nil {
+		return bundle, false
+	}
+	bundle = types.MustUnmarshalBundle(bz)
+	return bundle, true
+}
+
+// SetBundle stores a bundle
+func (k Keeper) SetBundle(ctx sdk.Context, bundle types.Bundle) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetBundleKey(bundle.ID), types.MustMarshalBundle(bundle))
+}
+
+// DeleteBundle removes a bundle
+func (k Keeper) DeleteBundle(ctx sdk.Context, id string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetBundleKey(id))
+}
+
+// IterateBundles iterates over all the bundles and performs a function
+func (k Keeper) IterateBundles(ctx sdk.Context, handler func(bundle types.Bundle) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.BundlesKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		bundle := types.MustUnmarshalBundle(iterator.Value())
+		if handler(bundle) {
+			break
+		}
+	}
+}
+
+// GetBundles returns all the bundles
+func (k Keeper) GetBundles(ctx sdk.Context) (bundles types.Bundles) {
+	k.IterateBundles(ctx, func(bundle types.Bundle) bool {
+		bundles = append(bundles, bundle)
+		return false
+	})
+	return
+}
+
+// WrapNFTs locks NFTs of the owner in escrow and mints a bundle NFT representing them to the
+// owner. Bundles can be wrapped in other bundles: only existing NFTs of the owner can be
+// wrapped, so a bundle can never end up inside itself.
+func (k Keeper) WrapNFTs(ctx sdk.Context, owner sdk.AccAddress, components []types.NFTRef) (types.Bundle, error) {
+	bundle := types.NewBundle(strconv.FormatUint(k.GetNextBundleID(ctx), 10), components)
+	if err := bundle.Validate(); err != nil {
+		return types.Bundle{}, err
+	}
+
+	// check everything before writing so a bad component doesn't leave the bundle half wrapped
+	nfts := make(types.NFTs, len(components))
+	for i, ref := range components {
+		nft, err := k.GetNFT(ctx, ref.Denom, ref.ID)
+		if err != nil {
+			return types.Bundle{}, err
+		}
+		if !nft.GetOwner().Equals(owner) {
+			return types.Bundle{}, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("%s is not the owner of NFT %s", owner, ref))
+		}
+		if err := types.CheckTransferable(nft); err != nil {
+			return types.Bundle{}, err
+		}
+		nfts[i] = nft
+	}
+
+	// the components can't stay listed for sale while they are wrapped
+	for i, nft := range nfts {
+		nft.SetOwner(types.EscrowAddress)
+		nft.EditPrice(sdk.NewCoins())
+		if err := k.UpdateNFT(ctx, components[i].Denom, nft); err != nil {
+			return types.Bundle{}, err
+		}
+	}
+
+	proof := types.BundleProof(bundle.ID)
+	nft := types.NewBaseNFT(bundle.ID, owner, types.HashProof(proof), proof,
+		fmt.Sprintf("Bundle of %d NFTs", len(components)), 0, 0, sdk.NewCoins())
+	nft.SetCreator(owner)
+	if err := k.MintNFT(ctx, types.BundleDenom, &nft); err != nil {
+		return types.Bundle{}, err
+	}
+
+	k.SetBundle(ctx, bundle)
+	id, _ := strconv.ParseUint(bundle.ID, 10, 64)
+	k.SetNextBundleID(ctx, id+1)
+	return bundle, nil
+}
+
+// UnwrapBundle burns a bundle NFT of the owner and gives the NFTs it wraps back to the owner
+func (k Keeper) UnwrapBundle(ctx sdk.Context, owner sdk.AccAddress, id string) (types.Bundle, error) {
+	bundle, found := k.GetBundle(ctx, id)
+	if !found {
+		return types.Bundle{}, sdkerrors.Wrap(types.ErrUnknownBundle, fmt.Sprintf("bundle %s doesn't exist", id))
+	}
+	nft, err := k.GetNFT(ctx, types.BundleDenom, id)
+	if err != nil {
+		return types.Bundle{}, err
+	}
+	if !nft.GetOwner().Equals(owner) {
+		return types.Bundle{}, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("%s is not the owner of bundle %s", owner, id))
+	}
+	// an escrowed, rented or frozen bundle has to stay whole
+	if err := types.CheckTransferable(nft); err != nil {
+		return types.Bundle{}, err
+	}
+
+	for _, ref := range bundle.Components {
+		component, err := k.GetNFT(ctx, ref.Denom, ref.ID)
+		if err != nil {
+			return types.Bundle{}, err
+		}
+		component.SetOwner(owner)
+		if err := k.UpdateNFT(ctx, ref.Denom, component); err != nil {
+			return types.Bundle{}, err
+		}
+	}
+
+	if err := k.DeleteNFT(ctx, types.BundleDenom, id); err != nil {
+		return types.Bundle{}, err
+	}
+	k.DeleteBundle(ctx, id)
+	return bundle, nil
+}
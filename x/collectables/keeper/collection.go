@@ -11,9 +11,7 @@ func (k Keeper) IterateCollections(ctx sdk.Context, handler func(collection type
 	iterator := sdk.KVStorePrefixIterator(store, types.CollectionsKeyPrefix)
 	defer iterator.Close()
 	for ; iterator.Valid(); iterator.Next() {
-		var collection types.Collection
-		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &collection)
-		if handler(collection) {
+		if handler(types.MustUnmarshalCollection(iterator.Value())) {
 			break
 		}
 	}
@@ -23,8 +21,7 @@ func (k Keeper) IterateCollections(ctx sdk.Context, handler func(collection type
 func (k Keeper) SetCollection(ctx sdk.Context, denom string, collection types.Collection) {
 	store := ctx.KVStore(k.storeKey)
 	collectionKey := types.GetCollectionKey(denom)
-	bz := k.cdc.MustMarshalBinaryLengthPrefixed(collection)
-	store.Set(collectionKey, bz)
+	store.Set(collectionKey, types.MustMarshalCollection(collection))
 }
 
 // GetCollection returns a collection of NFTs
@@ -35,8 +32,7 @@ func (k Keeper) GetCollection(ctx sdk.Context, denom string) (collection types.C
 	if bz == nil {
 		return
 	}
-	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &collection)
-	return collection, true
+	return types.MustUnmarshalCollection(bz), true
 }
 
 // GetCollections returns all the NFTs collections
@@ -60,3 +56,46 @@ func (k Keeper) GetDenoms(ctx sdk.Context) (denoms []string) {
 	)
 	return
 }
+
+// SearchNFTs returns the NFTs of a collection, or of all collections if the denom is empty,
+// whose traits match all the given traits. Only the NFTs indexed under the least frequent of
+// the traits are checked.
+func (k Keeper) SearchNFTs(ctx sdk.Context, denom string, traits types.Traits) (collections types.Collections) {
+	denoms := []string{denom}
+	if denom == "" {
+		denoms = k.GetDenoms(ctx)
+	}
+	for _, denom := range denoms {
+		collection, found := k.GetCollection(ctx, denom)
+		if !found {
+			continue
+		}
+		var nfts types.NFTs
+		if len(traits) == 0 {
+			nfts = collection.NFTs
+		} else {
+			for _, id := range k.GetTraitNFTs(ctx, denom, k.rarestTrait(ctx, denom, traits)) {
+				nft, err := collection.GetNFT(id)
+				if err == nil && nft.GetTraits().Matches(traits) {
+					nfts = append(nfts, nft)
+				}
+			}
+		}
+		if len(nfts) > 0 {
+			collections = append(collections, types.NewCollection(collection.Denom, nfts))
+		}
+	}
+	return types.NewCollections(collections...)
+}
+
+// rarestTrait returns the trait held by the fewest NFTs of a collection
+func (k Keeper) rarestTrait(ctx sdk.Context, denom string, traits types.Traits) types.Trait {
+	rarest := traits[0]
+	count := k.GetTraitCount(ctx, denom, rarest)
+	for _, trait := range traits[1:] {
+		if c := k.GetTraitCount(ctx, denom, trait); c < count {
+			rarest, count = trait, c
+		}
+	}
+	return rarest
+}
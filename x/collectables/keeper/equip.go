@@ -0,0 +1,179 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/tosch110/collectables/x/collectables/types"
+)
+
+// SetEquipped indexes an NFT equipped onto a parent NFT
+func (k Keeper) SetEquipped(ctx sdk.Context, parent, child types.NFTRef) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetEquippedKey(parent, child), types.MustMarshalNFTRef(child))
+}
+
+// DeleteEquipped removes the index of an NFT equipped onto a parent NFT
+func (k Keeper) DeleteEquipped(ctx sdk.Context, parent, child types.NFTRef) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetEquippedKey(parent, child))
+}
+
+// IterateEquipment iterates over the NFTs equipped directly onto a parent NFT and performs a function
+func (k Keeper) IterateEquipment(ctx sdk.Context, parent types.NFTRef, handler func(child types.NFTRef) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetEquipmentKey(parent.Denom, parent.ID))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		child := types.MustUnmarshalNFTRef(iterator.Value())
+		if handler(child) {
+			break
+		}
+	}
+}
+
+// GetEquipment returns the NFTs equipped directly onto a parent NFT
+func (k Keeper) GetEquipment(ctx sdk.Context, parent types.NFTRef) (children []types.NFTRef) {
+	k.IterateEquipment(ctx, parent, func(child types.NFTRef) bool {
+		children = append(children, child)
+		return false
+	})
+	return
+}
+
+// HasEquipment returns true if at least one NFT is equipped onto a parent NFT
+func (k Keeper) HasEquipment(ctx sdk.Context, parent types.NFTRef) (found bool) {
+	k.IterateEquipment(ctx, parent, func(types.NFTRef) bool {
+		found = true
+		return true
+	})
+	return
+}
+
+// GetRootNFT returns the NFT at the top of the equipment tree of an NFT, which is owned by an account
+// and holds all the NFTs equipped below it. An NFT which isn't equipped is its own root.
+func (k Keeper) GetRootNFT(ctx sdk.Context, ref types.NFTRef, nft types.NFT) (types.NFTRef, types.NFT, error) {
+	for types.IsEquipped(nft) {
+		ref = nft.GetParent()
+		parent, err := k.GetNFT(ctx, ref.Denom, ref.ID)
+		if err != nil {
+			return types.NFTRef{}, nil, err
+		}
+		nft = parent
+	}
+	return ref, nft, nil
+}
+
+// Equip equips an NFT of the owner onto a parent NFT held by the same owner. The equipped NFT is
+// locked by the module and follows the parent until it gets unequipped.
+func (k Keeper) Equip(ctx sdk.Context, owner sdk.AccAddress, child, parent types.NFTRef) error {
+	if err := types.ValidateEquip(child, parent); err != nil {
+		return err
+	}
+	nft, err := k.GetNFT(ctx, child.Denom, child.ID)
+	if err != nil {
+		return err
+	}
+	if !nft.GetOwner().Equals(owner) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("%s is not the owner of NFT %s", owner, child))
+	}
+	if err := types.CheckTransferable(nft); err != nil {
+		return err
+	}
+
+	// the child isn't equipped, so it is the root of its own tree: walking up from the parent
+	// reaches it only if the parent is part of its equipment
+	parentNFT, err := k.GetNFT(ctx, parent.Denom, parent.ID)
+	if err != nil {
+		return err
+	}
+	ref, root := parent, parentNFT
+	for types.IsEquipped(root) {
+		ref = root.GetParent()
+		if ref == child {
+			return sdkerrors.Wrap(types.ErrEquipmentCycle, fmt.Sprintf("NFT %s is equipped below NFT %s", parent, child))
+		}
+		if root, err = k.GetNFT(ctx, ref.Denom, ref.ID); err != nil {
+			return err
+		}
+	}
+	if !root.GetOwner().Equals(owner) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("%s is not the owner of NFT %s", owner, ref))
+	}
+	if err := types.CheckUsable(root); err != nil {
+		return err
+	}
+	if types.IsRented(root) {
+		return sdkerrors.Wrap(types.ErrRented, fmt.Sprintf("NFT #%s is rented until block %d", root.GetID(), root.GetUserExpires()))
+	}
+	if len(k.GetEquipment(ctx, parent)) >= types.MaxEquipped {
+		return sdkerrors.Wrap(types.ErrInvalidEquipment, fmt.Sprintf("NFT %s already has %d NFTs equipped", parent, types.MaxEquipped))
+	}
+
+	nft.SetOwner(types.EscrowAddress)
+	nft.SetParent(parent)
+	nft.EditPrice(sdk.NewCoins())
+	if err := k.UpdateNFT(ctx, child.Denom, nft); err != nil {
+		return err
+	}
+	k.SetEquipped(ctx, parent, child)
+	return nil
+}
+
+// Unequip detaches an equipped NFT from its parent and gives it to the owner of its equipment tree
+func (k Keeper) Unequip(ctx sdk.Context, owner sdk.AccAddress, child types.NFTRef) (types.NFTRef, error) {
+	nft, err := k.GetNFT(ctx, child.Denom, child.ID)
+	if err != nil {
+		return types.NFTRef{}, err
+	}
+	if !types.IsEquipped(nft) {
+		return types.NFTRef{}, sdkerrors.Wrap(types.ErrInvalidEquipment, fmt.Sprintf("NFT %s is not equipped", child))
+	}
+	ref, root, err := k.GetRootNFT(ctx, child, nft)
+	if err != nil {
+		return types.NFTRef{}, err
+	}
+	if !root.GetOwner().Equals(owner) {
+		return types.NFTRef{}, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("%s is not the owner of NFT %s", owner, ref))
+	}
+	if err := types.CheckTransferable(root); err != nil {
+		return types.NFTRef{}, err
+	}
+
+	parent := nft.GetParent()
+	nft.SetOwner(owner)
+	nft.SetParent(types.NFTRef{})
+	if err := k.UpdateNFT(ctx, child.Denom, nft); err != nil {
+		return types.NFTRef{}, err
+	}
+	k.DeleteEquipped(ctx, parent, child)
+	return parent, nil
+}
+
+// GetEquippedStats returns the stats of an NFT increased by the stats of all the NFTs equipped below it
+func (k Keeper) GetEquippedStats(ctx sdk.Context, denom string, nft types.NFT) (types.Stats, error) {
+	stats, err := k.GetStats(ctx, denom, nft)
+	if err != nil {
+		return types.Stats{}, err
+	}
+	k.IterateEquipment(ctx, types.NewNFTRef(denom, nft.GetID()), func(child types.NFTRef) bool {
+		var childNFT types.NFT
+		childNFT, err = k.GetNFT(ctx, child.Denom, child.ID)
+		if err != nil {
+			return true
+		}
+		var bonus types.Stats
+		bonus, err = k.GetEquippedStats(ctx, child.Denom, childNFT)
+		if err != nil {
+			return true
+		}
+		stats = stats.WithBonus(bonus)
+		return false
+	})
+	if err != nil {
+		return types.Stats{}, err
+	}
+	return stats, nil
+}
@@ -0,0 +1,173 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/tosch110/collectables/x/collectables/types"
+)
+
+// GetVault returns the vault of a fractionalized NFT
+func (k Keeper) GetVault(ctx sdk.Context, denom, id string) (vault types.Vault, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetVaultKey(denom, id))
+	if bz == nil {
+		return vault, false
+	}
+	vault = types.MustUnmarshalVault(bz)
+	return vault, true
+}
+
+// SetVault stores the vault of a fractionalized NFT
+func (k Keeper) SetVault(ctx sdk.Context, vault types.Vault) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetVaultKey(vault.Denom, vault.NFTID), types.MustMarshalVault(vault))
+}
+
+// DeleteVault removes the vault of an NFT
+func (k Keeper) DeleteVault(ctx sdk.Context, vault types.Vault) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetVaultKey(vault.Denom, vault.NFTID))
+}
+
+// IterateVaults iterates over all the vaults and performs a function
+func (k Keeper) IterateVaults(ctx sdk.Context, handler func(vault types.Vault) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.VaultsKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		vault := types.MustUnmarshalVault(iterator.Value())
+		if handler(vault) {
+			break
+		}
+	}
+}
+
+// GetVaults returns all the vaults
+func (k Keeper) GetVaults(ctx sdk.Context) (vaults types.Vaults) {
+	k.IterateVaults(ctx, func(vault types.Vault) bool {
+		vaults = append(vaults, vault)
+		return false
+	})
+	return
+}
+
+// Fractionalize locks an NFT of the curator in a vault and mints its shares to the curator
+func (k Keeper) Fractionalize(ctx sdk.Context, curator sdk.AccAddress, denom, id string,
+	shares sdk.Int, reservePrice sdk.Coins) (types.Vault, error) {
+	nft, err := k.GetNFT(ctx, denom, id)
+	if err != nil {
+		return types.Vault{}, err
+	}
+	if !nft.GetOwner().Equals(curator) {
+		return types.Vault{}, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("%s is not the owner of NFT #%s", curator, id))
+	}
+	if err := types.CheckTransferable(nft); err != nil {
+		return types.Vault{}, err
+	}
+
+	vault := types.NewVault(denom, id, curator, shares, reservePrice)
+	if err := vault.Validate(); err != nil {
+		return types.Vault{}, err
+	}
+	// shares of a previous vault of the NFT may still be waiting for their buyout proceeds
+	if supply := k.SupplyKeeper.GetSupply(ctx).GetTotal().AmountOf(vault.ShareDenom); !supply.IsZero() {
+		return types.Vault{}, sdkerrors.Wrap(types.ErrInvalidVault, fmt.Sprintf("%s%s are already in circulation", supply, vault.ShareDenom))
+	}
+
+	// the NFT can't stay listed for sale while it is vaulted
+	nft.SetOwner(types.EscrowAddress)
+	nft.EditPrice(sdk.NewCoins())
+	if err := k.UpdateNFT(ctx, denom, nft); err != nil {
+		return types.Vault{}, err
+	}
+
+	if err := k.SupplyKeeper.MintCoins(ctx, types.ModuleName, vault.SharesCoins(shares)); err != nil {
+		return types.Vault{}, err
+	}
+	if err := k.SupplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, curator, vault.SharesCoins(shares)); err != nil {
+		return types.Vault{}, err
+	}
+
+	k.SetVault(ctx, vault)
+	return vault, nil
+}
+
+// Buyout pays the reserve price of a vault to the module account, where it waits for the
+// shareholders to redeem their shares, and gives the NFT to the buyer
+func (k Keeper) Buyout(ctx sdk.Context, buyer sdk.AccAddress, vault types.Vault) (types.Vault, error) {
+	if vault.IsBoughtOut() {
+		return vault, sdkerrors.Wrap(types.ErrInvalidVault, fmt.Sprintf("NFT #%s was already bought out by %s", vault.NFTID, vault.Buyer))
+	}
+	if vault.ReservePrice.Empty() {
+		return vault, sdkerrors.Wrap(types.ErrInvalidVault, fmt.Sprintf("NFT #%s has no reserve price", vault.NFTID))
+	}
+	if err := k.SupplyKeeper.SendCoinsFromAccountToModule(ctx, buyer, types.ModuleName, vault.ReservePrice); err != nil {
+		return vault, err
+	}
+
+	vault.Buyer = buyer
+	vault.Proceeds = vault.ReservePrice
+	if err := k.releaseVaultedNFT(ctx, vault, buyer); err != nil {
+		return vault, err
+	}
+	k.SetVault(ctx, vault)
+	return vault, nil
+}
+
+// Redeem burns all the shares of a vault held by the holder. Before a buyout the holder must
+// hold every share in circulation and gets the NFT back, after a buyout the holder gets its
+// part of the proceeds. The vault is deleted once no share is left in circulation.
+func (k Keeper) Redeem(ctx sdk.Context, holder sdk.AccAddress, vault types.Vault) (types.Vault, sdk.Coins, error) {
+	shares := k.CoinKeeper.GetCoins(ctx, holder).AmountOf(vault.ShareDenom)
+	if shares.IsZero() {
+		return vault, nil, sdkerrors.Wrap(types.ErrInsufficientShares, fmt.Sprintf("%s holds no %s", holder, vault.ShareDenom))
+	}
+
+	payout := sdk.NewCoins()
+	if vault.IsBoughtOut() {
+		payout = vault.Payout(shares)
+		vault.Proceeds = vault.Proceeds.Sub(payout)
+	} else {
+		if !shares.Equal(vault.Shares) {
+			return vault, nil, sdkerrors.Wrap(types.ErrInsufficientShares,
+				fmt.Sprintf("redeeming NFT #%s needs %s%s, %s holds %s", vault.NFTID, vault.Shares, vault.ShareDenom, holder, shares),
+			)
+		}
+		if err := k.releaseVaultedNFT(ctx, vault, holder); err != nil {
+			return vault, nil, err
+		}
+	}
+
+	if err := k.SupplyKeeper.SendCoinsFromAccountToModule(ctx, holder, types.ModuleName, vault.SharesCoins(shares)); err != nil {
+		return vault, nil, err
+	}
+	if err := k.SupplyKeeper.BurnCoins(ctx, types.ModuleName, vault.SharesCoins(shares)); err != nil {
+		return vault, nil, err
+	}
+	if !payout.IsZero() {
+		if err := k.SupplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, holder, payout); err != nil {
+			return vault, nil, err
+		}
+	}
+
+	vault.Shares = vault.Shares.Sub(shares)
+	if vault.Shares.IsZero() {
+		k.DeleteVault(ctx, vault)
+	} else {
+		k.SetVault(ctx, vault)
+	}
+	return vault, payout, nil
+}
+
+// releaseVaultedNFT gives the NFT of a vault to the recipient
+func (k Keeper) releaseVaultedNFT(ctx sdk.Context, vault types.Vault, recipient sdk.AccAddress) error {
+	nft, err := k.GetNFT(ctx, vault.Denom, vault.NFTID)
+	if err != nil {
+		return err
+	}
+	nft.SetOwner(recipient)
+	return k.UpdateNFT(ctx, vault.Denom, nft)
+}
@@ -0,0 +1,156 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/tosch110/collectables/x/collectables/types"
+	"github.com/tosch110/collectables/x/collectables/types/v1beta1"
+)
+
+var _ v1beta1.QueryServer = Keeper{}
+
+type sdkContextKey struct{}
+
+// WrapSDKContext returns the context the Query service has to be called with to run against the state of ctx
+func WrapSDKContext(ctx sdk.Context) context.Context {
+	return context.WithValue(ctx.Context(), sdkContextKey{}, ctx)
+}
+
+func unwrapSDKContext(c context.Context) sdk.Context {
+	return c.Value(sdkContextKey{}).(sdk.Context)
+}
+
+// Supply returns the number of NFTs of a collection
+func (k Keeper) Supply(c context.Context, req *v1beta1.QuerySupplyRequest) (*v1beta1.QuerySupplyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	collection, found := k.GetCollection(unwrapSDKContext(c), req.Denom)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrUnknownCollection, fmt.Sprintf("unknown denom %s", req.Denom))
+	}
+	return &v1beta1.QuerySupplyResponse{Amount: uint64(collection.Supply())}, nil
+}
+
+// Owner returns the NFTs of all collections owned by an address
+func (k Keeper) Owner(c context.Context, req *v1beta1.QueryOwnerRequest) (*v1beta1.QueryOwnerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	address, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	owner := k.GetOwner(unwrapSDKContext(c), address)
+	return &v1beta1.QueryOwnerResponse{Owner: owner.ToProto()}, nil
+}
+
+// OwnerByDenom returns the NFTs of a collection owned by an address
+func (k Keeper) OwnerByDenom(c context.Context, req *v1beta1.QueryOwnerByDenomRequest) (*v1beta1.QueryOwnerByDenomResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	address, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	idCollection, _ := k.GetOwnerByDenom(unwrapSDKContext(c), address, req.Denom)
+	owner := types.NewOwner(address, idCollection)
+	return &v1beta1.QueryOwnerByDenomResponse{Owner: owner.ToProto()}, nil
+}
+
+// Collection returns a collection and all its NFTs
+func (k Keeper) Collection(c context.Context, req *v1beta1.QueryCollectionRequest) (*v1beta1.QueryCollectionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	collection, found := k.GetCollection(unwrapSDKContext(c), req.Denom)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrUnknownCollection, fmt.Sprintf("unknown denom %s", req.Denom))
+	}
+	return &v1beta1.QueryCollectionResponse{Collection: collection.ToProto()}, nil
+}
+
+// Denoms returns the denoms of all collections
+func (k Keeper) Denoms(c context.Context, req *v1beta1.QueryDenomsRequest) (*v1beta1.QueryDenomsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	return &v1beta1.QueryDenomsResponse{Denoms: k.GetDenoms(unwrapSDKContext(c))}, nil
+}
+
+// NFT returns a single NFT of a collection
+func (k Keeper) NFT(c context.Context, req *v1beta1.QueryNFTRequest) (*v1beta1.QueryNFTResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	nft, err := k.GetNFT(unwrapSDKContext(c), req.Denom, req.ID)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrUnknownNFT, fmt.Sprintf("invalid NFT #%s from collection %s", req.ID, req.Denom))
+	}
+	return &v1beta1.QueryNFTResponse{NFT: types.NFTToProto(nft)}, nil
+}
+
+// queryGRPC serves a method of the Query service through the querier, at custom/collectables/grpc/<method>.
+// The request and the response are protobuf encoded.
+func queryGRPC(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	if len(path) != 1 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "expected a method of the Query service")
+	}
+
+	var (
+		request  protoMessage
+		response func(context.Context) (protoMessage, error)
+	)
+	switch path[0] {
+	case "Supply":
+		r := &v1beta1.QuerySupplyRequest{}
+		request, response = r, func(c context.Context) (protoMessage, error) { return k.Supply(c, r) }
+	case "Owner":
+		r := &v1beta1.QueryOwnerRequest{}
+		request, response = r, func(c context.Context) (protoMessage, error) { return k.Owner(c, r) }
+	case "OwnerByDenom":
+		r := &v1beta1.QueryOwnerByDenomRequest{}
+		request, response = r, func(c context.Context) (protoMessage, error) { return k.OwnerByDenom(c, r) }
+	case "Collection":
+		r := &v1beta1.QueryCollectionRequest{}
+		request, response = r, func(c context.Context) (protoMessage, error) { return k.Collection(c, r) }
+	case "Denoms":
+		r := &v1beta1.QueryDenomsRequest{}
+		request, response = r, func(c context.Context) (protoMessage, error) { return k.Denoms(c, r) }
+	case "NFT":
+		r := &v1beta1.QueryNFTRequest{}
+		request, response = r, func(c context.Context) (protoMessage, error) { return k.NFT(c, r) }
+	default:
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, fmt.Sprintf("unknown method %s of the Query service", path[0]))
+	}
+
+	if err := request.Unmarshal(req.Data); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	res, err := response(WrapSDKContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return res.Marshal()
+}
+
+// protoMessage is implemented by the requests and responses of the Query service
+type protoMessage interface {
+	Marshal() ([]byte, error)
+	Unmarshal([]byte) error
+}
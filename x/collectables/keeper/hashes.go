@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tosch110/collectables/x/collectables/types"
+)
+
+// GetNFTRefByHash returns the NFT of a collection that was minted with a hash
+func (k Keeper) GetNFTRefByHash(ctx sdk.Context, denom, hash string) (ref types.NFTRef, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetHashKey(hash, denom))
+	if bz == nil {
+		return ref, false
+	}
+	ref = types.MustUnmarshalNFTRef(bz)
+	return ref, true
+}
+
+// HasHash returns whether a hash was already used to mint an NFT of a collection
+func (k Keeper) HasHash(ctx sdk.Context, denom, hash string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetHashKey(hash, denom))
+}
+
+// SetHash indexes the NFT of a collection by its hash
+func (k Keeper) SetHash(ctx sdk.Context, denom, hash, id string) {
+	store := ctx.KVStore(k.storeKey)
+	bz := types.MustMarshalNFTRef(types.NewNFTRef(denom, id))
+	store.Set(types.GetHashKey(hash, denom), bz)
+}
+
+// DeleteHash removes the hash index of an NFT of a collection
+func (k Keeper) DeleteHash(ctx sdk.Context, denom, hash string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetHashKey(hash, denom))
+}
+
+// IterateHashes iterates over the NFTs of all collections minted with a hash and performs a function
+func (k Keeper) IterateHashes(ctx sdk.Context, hash string, handler func(ref types.NFTRef) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetHashesKey(hash))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		ref := types.MustUnmarshalNFTRef(iterator.Value())
+		if handler(ref) {
+			break
+		}
+	}
+}
+
+// GetNFTRefsByHash returns the NFTs of all collections minted with a hash
+func (k Keeper) GetNFTRefsByHash(ctx sdk.Context, hash string) (refs []types.NFTRef) {
+	k.IterateHashes(ctx, hash,
+		func(ref types.NFTRef) (stop bool) {
+			refs = append(refs, ref)
+			return false
+		},
+	)
+	return
+}
@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tosch110/collectables/x/collectables/types"
+)
+
+// SetHistoryEntry sets an entry of the history of an NFT
+func (k Keeper) SetHistoryEntry(ctx sdk.Context, entry types.HistoryEntry) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetHistoryEntryKey(entry.Denom, entry.ID, entry.Sequence)
+	store.Set(key, types.MustMarshalHistoryEntry(entry))
+}
+
+// IterateHistory iterates over the history of an NFT from the oldest entry kept and performs a function
+func (k Keeper) IterateHistory(ctx sdk.Context, denom, id string, handler func(entry types.HistoryEntry) (stop bool)) {
+	k.iterateHistory(ctx, types.GetHistoryKey(denom, id), handler)
+}
+
+// IterateAllHistory iterates over the history entries of all the NFTs and performs a function
+func (k Keeper) IterateAllHistory(ctx sdk.Context, handler func(entry types.HistoryEntry) (stop bool)) {
+	k.iterateHistory(ctx, types.HistoryKeyPrefix, handler)
+}
+
+func (k Keeper) iterateHistory(ctx sdk.Context, prefix []byte, handler func(entry types.HistoryEntry) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		entry := types.MustUnmarshalHistoryEntry(iterator.Value())
+		if handler(entry) {
+			break
+		}
+	}
+}
+
+// GetHistory returns the history of an NFT, it is kept once the NFT is burned
+func (k Keeper) GetHistory(ctx sdk.Context, denom, id string) (history types.History) {
+	k.IterateHistory(ctx, denom, id, func(entry types.HistoryEntry) bool {
+		history = append(history, entry)
+		return false
+	})
+	return
+}
+
+// GetAllHistory returns the history entries of all the NFTs
+func (k Keeper) GetAllHistory(ctx sdk.Context) (history types.History) {
+	k.IterateAllHistory(ctx, func(entry types.HistoryEntry) bool {
+		history = append(history, entry)
+		return false
+	})
+	return
+}
+
+// getLastHistoryEntry returns the newest entry of the history of an NFT
+func (k Keeper) getLastHistoryEntry(ctx sdk.Context, denom, id string) (entry types.HistoryEntry, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStoreReversePrefixIterator(store, types.GetHistoryKey(denom, id))
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return entry, false
+	}
+	entry = types.MustUnmarshalHistoryEntry(iterator.Value())
+	return entry, true
+}
+
+// AppendHistory appends an entry to the history of an NFT at the current block height. The
+// oldest entries are pruned so that the history keeps at most the history limit entries.
+func (k Keeper) AppendHistory(ctx sdk.Context, denom, id string, entry types.HistoryEntry) {
+	entry.Denom = denom
+	entry.ID = id
+	entry.Height = ctx.BlockHeight()
+	entry.Sequence = 1
+	if last, found := k.getLastHistoryEntry(ctx, denom, id); found {
+		entry.Sequence = last.Sequence + 1
+	}
+
+	limit := k.HistoryLimit(ctx)
+	if limit > 0 {
+		k.SetHistoryEntry(ctx, entry)
+	}
+	k.pruneHistory(ctx, denom, id, entry.Sequence, limit)
+}
+
+// pruneHistory deletes the entries of the history of an NFT which are more than limit entries older
+// than the newest one
+func (k Keeper) pruneHistory(ctx sdk.Context, denom, id string, newest, limit uint64) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetHistoryKey(denom, id))
+	defer iterator.Close()
+
+	var pruned [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		sequence := binary.BigEndian.Uint64(key[len(key)-8:])
+		if newest-sequence < limit {
+			break
+		}
+		pruned = append(pruned, key)
+	}
+	for _, key := range pruned {
+		store.Delete(key)
+	}
+}
+
+// recordSale records a sale in the history of an NFT. The transfer recorded when the NFT changed
+// hands is turned into the sale rather than being followed by it.
+func (k Keeper) recordSale(ctx sdk.Context, denom, id string, seller, buyer sdk.AccAddress, price sdk.Coins) {
+	last, found := k.getLastHistoryEntry(ctx, denom, id)
+	if found && last.Action == types.HistoryActionTransfer && last.Height == ctx.BlockHeight() &&
+		last.From.Equals(seller) && last.To.Equals(buyer) {
+		last.Action = types.HistoryActionSale
+		last.Price = price
+		k.SetHistoryEntry(ctx, last)
+		return
+	}
+	k.AppendHistory(ctx, denom, id, types.NewSaleEntry(seller, buyer, price))
+}
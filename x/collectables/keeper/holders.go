@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tosch110/collectables/x/collectables/types"
+)
+
+// SetCreated indexes an NFT minted by a creator
+func (k Keeper) SetCreated(ctx sdk.Context, creator sdk.AccAddress, ref types.NFTRef) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetCreatedKey(creator, ref), types.MustMarshalNFTRef(ref))
+}
+
+// DeleteCreated removes the index of an NFT minted by a creator
+func (k Keeper) DeleteCreated(ctx sdk.Context, creator sdk.AccAddress, ref types.NFTRef) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetCreatedKey(creator, ref))
+}
+
+// GetCreatedBy returns the existing NFTs minted by a creator
+func (k Keeper) GetCreatedBy(ctx sdk.Context, creator sdk.AccAddress) []types.NFTRef {
+	return k.getRefs(ctx, types.GetCreatorKey(creator))
+}
+
+// SetHeld indexes an NFT held by an account, the index is kept once the account doesn't own it anymore
+func (k Keeper) SetHeld(ctx sdk.Context, holder sdk.AccAddress, ref types.NFTRef) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetHeldKey(holder, ref), types.MustMarshalNFTRef(ref))
+}
+
+// GetHeldBy returns the NFTs ever held by an account, including the burned ones
+func (k Keeper) GetHeldBy(ctx sdk.Context, holder sdk.AccAddress) []types.NFTRef {
+	return k.getRefs(ctx, types.GetHolderKey(holder))
+}
+
+// SetHolders indexes the NFTs held by accounts
+func (k Keeper) SetHolders(ctx sdk.Context, holders []types.Holder) {
+	for _, holder := range holders {
+		for _, ref := range holder.NFTs {
+			k.SetHeld(ctx, holder.Address, ref)
+		}
+	}
+}
+
+// GetHolders returns all the accounts which ever held an NFT, sorted by address
+func (k Keeper) GetHolders(ctx sdk.Context) (holders []types.Holder) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.HoldersKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		ref := types.MustUnmarshalNFTRef(iterator.Value())
+
+		address := types.SplitHeldKey(iterator.Key())
+		if len(holders) == 0 || !holders[len(holders)-1].Address.Equals(address) {
+			holders = append(holders, types.NewHolder(address))
+		}
+		holders[len(holders)-1].NFTs = append(holders[len(holders)-1].NFTs, ref)
+	}
+	return
+}
+
+// getRefs returns the NFTs referenced by the values of all the keys under a prefix
+func (k Keeper) getRefs(ctx sdk.Context, prefix []byte) (refs []types.NFTRef) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		ref := types.MustUnmarshalNFTRef(iterator.Value())
+		refs = append(refs, ref)
+	}
+	return
+}
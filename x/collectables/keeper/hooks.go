@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tosch110/collectables/x/collectables/types"
+)
+
+// Implements CollectablesHooks
+var _ types.CollectablesHooks = Keeper{}
+
+// SetHooks sets the collectables hooks
+func (k *Keeper) SetHooks(h types.CollectablesHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set collectables hooks twice")
+	}
+	k.hooks = h
+	return k
+}
+
+// AfterMint - record the mint in the history of the NFT and call hook if registered
+func (k Keeper) AfterMint(ctx sdk.Context, denom, id string, owner sdk.AccAddress) {
+	k.AppendHistory(ctx, denom, id, types.NewMintEntry(owner))
+	if k.hooks != nil {
+		k.hooks.AfterMint(ctx, denom, id, owner)
+	}
+}
+
+// BeforeTransfer - call hook if registered
+func (k Keeper) BeforeTransfer(ctx sdk.Context, denom, id string, from, to sdk.AccAddress) {
+	if k.hooks != nil {
+		k.hooks.BeforeTransfer(ctx, denom, id, from, to)
+	}
+}
+
+// AfterTransfer - record the transfer in the history of the NFT and call hook if registered
+func (k Keeper) AfterTransfer(ctx sdk.Context, denom, id string, from, to sdk.AccAddress) {
+	k.AppendHistory(ctx, denom, id, types.NewTransferEntry(from, to))
+	if k.hooks != nil {
+		k.hooks.AfterTransfer(ctx, denom, id, from, to)
+	}
+}
+
+// AfterBurn - record the burn in the history of the NFT and call hook if registered
+func (k Keeper) AfterBurn(ctx sdk.Context, denom, id string, owner sdk.AccAddress) {
+	k.AppendHistory(ctx, denom, id, types.NewBurnEntry(owner))
+	if k.hooks != nil {
+		k.hooks.AfterBurn(ctx, denom, id, owner)
+	}
+}
+
+// AfterChallenge - record the challenge in the history of both NFTs and call hook if registered
+func (k Keeper) AfterChallenge(ctx sdk.Context, winner, loser types.NFTRef) {
+	k.AppendHistory(ctx, winner.Denom, winner.ID, types.NewChallengeEntry(loser, true))
+	k.AppendHistory(ctx, loser.Denom, loser.ID, types.NewChallengeEntry(winner, false))
+	if k.hooks != nil {
+		k.hooks.AfterChallenge(ctx, winner, loser)
+	}
+}
+
+// AfterSale - record the sale in the history of the NFT and call hook if registered
+func (k Keeper) AfterSale(ctx sdk.Context, denom, id string, seller, buyer sdk.AccAddress, price sdk.Coins) {
+	k.recordSale(ctx, denom, id, seller, buyer, price)
+	if k.hooks != nil {
+		k.hooks.AfterSale(ctx, denom, id, seller, buyer, price)
+	}
+}
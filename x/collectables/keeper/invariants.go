@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"sort"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/tosch110/collectables/x/collectables/types"
@@ -13,12 +14,49 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 		types.ModuleName, "supply",
 		SupplyInvariant(k),
 	)
+	ir.RegisterRoute(
+		types.ModuleName, "ownership",
+		OwnershipInvariant(k),
+	)
+	ir.RegisterRoute(
+		types.ModuleName, "unique-ownership",
+		UniqueOwnershipInvariant(k),
+	)
+	ir.RegisterRoute(
+		types.ModuleName, "owner-references",
+		OwnerReferencesInvariant(k),
+	)
+	ir.RegisterRoute(
+		types.ModuleName, "empty-owners",
+		EmptyOwnersInvariant(k),
+	)
+	ir.RegisterRoute(
+		types.ModuleName, "provenance",
+		ProvenanceInvariant(k),
+	)
+	ir.RegisterRoute(
+		types.ModuleName, "escrow",
+		EscrowInvariant(k),
+	)
 }
 
 // AllInvariants runs all invariants of the nfts module.
 func AllInvariants(k Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
-		return SupplyInvariant(k)(ctx)
+		for _, invariant := range []sdk.Invariant{
+			SupplyInvariant(k),
+			OwnershipInvariant(k),
+			UniqueOwnershipInvariant(k),
+			OwnerReferencesInvariant(k),
+			EmptyOwnersInvariant(k),
+			ProvenanceInvariant(k),
+		} {
+			res, stop := invariant(ctx)
+			if stop {
+				return res, stop
+			}
+		}
+		return EscrowInvariant(k)(ctx)
 	}
 }
 
@@ -55,3 +93,271 @@ func SupplyInvariant(k Keeper) sdk.Invariant {
 			"%d NFT supply invariants found\n%s", count, msg)), broken
 	}
 }
+
+// OwnershipInvariant checks that every NFT is recorded in the owner records of its owner
+func OwnershipInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		count := 0
+
+		records := getOwnerRecords(ctx, k)
+		k.IterateCollections(ctx, func(collection types.Collection) bool {
+			for _, nft := range collection.NFTs {
+				ref := types.NewNFTRef(collection.Denom, nft.GetID())
+				found := false
+				for _, owner := range records[ref] {
+					if owner.Equals(nft.GetOwner()) {
+						found = true
+						break
+					}
+				}
+				if !found {
+					count++
+					msg += fmt.Sprintf("\tNFT %s isn't recorded for its owner %s\n", ref, nft.GetOwner())
+				}
+			}
+			return false
+		})
+		broken := count != 0
+
+		return sdk.FormatInvariant(types.ModuleName, "ownership", fmt.Sprintf(
+			"%d NFT ownership invariants found\n%s", count, msg)), broken
+	}
+}
+
+// UniqueOwnershipInvariant checks that no NFT is recorded more than once in the owner records
+func UniqueOwnershipInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		count := 0
+
+		records := getOwnerRecords(ctx, k)
+		for _, ref := range sortedRefs(records) {
+			if owners := records[ref]; len(owners) > 1 {
+				count++
+				msg += fmt.Sprintf("\tNFT %s is recorded %d times, for %v\n", ref, len(owners), owners)
+			}
+		}
+		broken := count != 0
+
+		return sdk.FormatInvariant(types.ModuleName, "unique-ownership", fmt.Sprintf(
+			"%d NFT unique ownership invariants found\n%s", count, msg)), broken
+	}
+}
+
+// OwnerReferencesInvariant checks that the owner records only reference existing NFTs held by the recorded owner
+func OwnerReferencesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		count := 0
+
+		k.IterateIDCollections(ctx, types.OwnersKeyPrefix,
+			func(owner sdk.AccAddress, idCollection types.IDCollection) bool {
+				for _, id := range idCollection.IDs {
+					nft, err := k.GetNFT(ctx, idCollection.Denom, id)
+					if err != nil {
+						count++
+						msg += fmt.Sprintf("\towner %s references NFT %s/%s which doesn't exist\n", owner, idCollection.Denom, id)
+						continue
+					}
+					if !nft.GetOwner().Equals(owner) {
+						count++
+						msg += fmt.Sprintf("\towner %s references NFT %s/%s owned by %s\n", owner, idCollection.Denom, id, nft.GetOwner())
+					}
+				}
+				return false
+			},
+		)
+		broken := count != 0
+
+		return sdk.FormatInvariant(types.ModuleName, "owner-references", fmt.Sprintf(
+			"%d NFT owner reference invariants found\n%s", count, msg)), broken
+	}
+}
+
+// EmptyOwnersInvariant checks that the owner records of addresses which don't own any NFT of a denom are pruned
+func EmptyOwnersInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		count := 0
+
+		k.IterateIDCollections(ctx, types.OwnersKeyPrefix,
+			func(owner sdk.AccAddress, idCollection types.IDCollection) bool {
+				if idCollection.Supply() == 0 {
+					count++
+					msg += fmt.Sprintf("\towner %s has an empty record for %s\n", owner, idCollection.Denom)
+				}
+				return false
+			},
+		)
+		broken := count != 0
+
+		return sdk.FormatInvariant(types.ModuleName, "empty-owners", fmt.Sprintf(
+			"%d NFT empty owner invariants found\n%s", count, msg)), broken
+	}
+}
+
+// ProvenanceInvariant checks that every NFT is indexed under its owner as a holder and under its creator
+func ProvenanceInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		count := 0
+
+		store := ctx.KVStore(k.storeKey)
+		k.IterateCollections(ctx, func(collection types.Collection) bool {
+			for _, nft := range collection.NFTs {
+				ref := types.NewNFTRef(collection.Denom, nft.GetID())
+				if !store.Has(types.GetHeldKey(nft.GetOwner(), ref)) {
+					count++
+					msg += fmt.Sprintf("\tNFT %s isn't indexed as held by its owner %s\n", ref, nft.GetOwner())
+				}
+				if !nft.GetCreator().Empty() && !store.Has(types.GetCreatedKey(nft.GetCreator(), ref)) {
+					count++
+					msg += fmt.Sprintf("\tNFT %s isn't indexed as created by %s\n", ref, nft.GetCreator())
+				}
+			}
+			return false
+		})
+		broken := count != 0
+
+		return sdk.FormatInvariant(types.ModuleName, "provenance", fmt.Sprintf(
+			"%d NFT provenance invariants found\n%s", count, msg)), broken
+	}
+}
+
+// getOwnerRecords returns the addresses whose owner records list each NFT
+func getOwnerRecords(ctx sdk.Context, k Keeper) map[types.NFTRef][]sdk.AccAddress {
+	records := make(map[types.NFTRef][]sdk.AccAddress)
+	k.IterateIDCollections(ctx, types.OwnersKeyPrefix,
+		func(owner sdk.AccAddress, idCollection types.IDCollection) bool {
+			for _, id := range idCollection.IDs {
+				ref := types.NewNFTRef(idCollection.Denom, id)
+				records[ref] = append(records[ref], owner)
+			}
+			return false
+		},
+	)
+	return records
+}
+
+// sortedRefs returns the NFTs of the owner records in a deterministic order
+func sortedRefs(records map[types.NFTRef][]sdk.AccAddress) []types.NFTRef {
+	refs := make([]types.NFTRef, 0, len(records))
+	for ref := range records {
+		refs = append(refs, ref)
+	}
+	sort.Slice(refs, func(i, j int) bool { return refs[i].String() < refs[j].String() })
+	return refs
+}
+
+// EscrowInvariant checks that every escrowed NFT is locked exactly once and that the escrowed coins are backed by the bank state
+func EscrowInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		count := 0
+
+		checkEscrowed := func(denom, id, lock string) {
+			nft, err := k.GetNFT(ctx, denom, id)
+			if err != nil {
+				count++
+				msg += fmt.Sprintf("\tNFT %s/%s of %s doesn't exist\n", denom, id, lock)
+				return
+			}
+			if !nft.GetOwner().Equals(types.EscrowAddress) {
+				count++
+				msg += fmt.Sprintf("\tNFT %s/%s of %s is owned by %s\n", denom, id, lock, nft.GetOwner())
+			}
+		}
+
+		locks := make(map[string]string)
+		lock := func(ref, by string) {
+			if other, ok := locks[ref]; ok {
+				count++
+				msg += fmt.Sprintf("\tNFT %s is locked by %s and %s\n", ref, other, by)
+			}
+			locks[ref] = by
+		}
+
+		k.IterateLoans(ctx, func(loan types.Loan) bool {
+			by := fmt.Sprintf("loan %d", loan.ID)
+			lock(types.NewNFTRef(loan.Denom, loan.NFTID).String(), by)
+			checkEscrowed(loan.Denom, loan.NFTID, by)
+			return false
+		})
+
+		k.IterateBundles(ctx, func(bundle types.Bundle) bool {
+			by := fmt.Sprintf("bundle %s", bundle.ID)
+			for _, ref := range bundle.Components {
+				lock(ref.String(), by)
+				checkEscrowed(ref.Denom, ref.ID, by)
+			}
+			if !k.IsNFT(ctx, types.BundleDenom, bundle.ID) {
+				count++
+				msg += fmt.Sprintf("\tNFT of %s doesn't exist\n", by)
+			}
+			return false
+		})
+
+		escrowedCoins := sdk.NewCoins()
+		k.IterateSwaps(ctx, func(swap types.Swap) bool {
+			by := fmt.Sprintf("swap %d", swap.ID)
+			for _, ref := range swap.OfferedNFTs {
+				lock(ref.String(), by)
+				checkEscrowed(ref.Denom, ref.ID, by)
+			}
+			escrowedCoins = escrowedCoins.Add(swap.OfferedCoins...)
+			return false
+		})
+
+		supply := k.SupplyKeeper.GetSupply(ctx).GetTotal()
+		k.IterateVaults(ctx, func(vault types.Vault) bool {
+			by := fmt.Sprintf("vault %s", vault.ShareDenom)
+			if !vault.IsBoughtOut() {
+				lock(types.NewNFTRef(vault.Denom, vault.NFTID).String(), by)
+				checkEscrowed(vault.Denom, vault.NFTID, by)
+			}
+			if shares := supply.AmountOf(vault.ShareDenom); !shares.Equal(vault.Shares) {
+				count++
+				msg += fmt.Sprintf("\t%s has %s shares in circulation, the bank supply is %s\n", by, vault.Shares, shares)
+			}
+			escrowedCoins = escrowedCoins.Add(vault.Proceeds...)
+			return false
+		})
+		if balance := k.CoinKeeper.GetCoins(ctx, types.EscrowAddress); !balance.IsAllGTE(escrowedCoins) {
+			count++
+			msg += fmt.Sprintf("\tescrow balance %s doesn't cover the buyout proceeds and the swapped coins %s\n", balance, escrowedCoins)
+		}
+
+		k.IterateCollections(ctx, func(collection types.Collection) bool {
+			for _, nft := range collection.NFTs {
+				ref := types.NewNFTRef(collection.Denom, nft.GetID()).String()
+				if types.IsEquipped(nft) {
+					parent := nft.GetParent()
+					by := fmt.Sprintf("parent %s", parent)
+					lock(ref, by)
+					checkEscrowed(collection.Denom, nft.GetID(), by)
+					if !k.IsNFT(ctx, parent.Denom, parent.ID) {
+						count++
+						msg += fmt.Sprintf("\tNFT %s is equipped onto %s which doesn't exist\n", ref, parent)
+					}
+				}
+				if _, ok := locks[ref]; !ok && nft.GetOwner().Equals(types.EscrowAddress) {
+					count++
+					msg += fmt.Sprintf("\tescrowed NFT %s isn't locked by a loan, a vault, a bundle, a swap or a parent\n", ref)
+				}
+				if collection.Denom != types.BundleDenom {
+					continue
+				}
+				if _, found := k.GetBundle(ctx, nft.GetID()); !found {
+					count++
+					msg += fmt.Sprintf("\tbundle NFT %s doesn't wrap anything\n", ref)
+				}
+			}
+			return false
+		})
+		broken := count != 0
+
+		return sdk.FormatInvariant(types.ModuleName, "escrow", fmt.Sprintf(
+			"%d NFT escrow invariants found\n%s", count, msg)), broken
+	}
+}
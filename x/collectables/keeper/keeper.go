@@ -8,25 +8,44 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
 
 	"github.com/tosch110/collectables/x/collectables/types"
 )
 
 // Keeper maintains the link to data storage and exposes getter/setter methods for the various parts of the state machine
 type Keeper struct {
-	CoinKeeper types.BankKeeper
+	CoinKeeper   types.BankKeeper
+	SupplyKeeper types.SupplyKeeper
 
 	storeKey sdk.StoreKey // Unexposed key to access store from sdk.Context
 
 	cdc *codec.Codec // The amino codec for binary encoding/decoding.
+
+	paramSpace params.Subspace // Subspace of the module parameters
+
+	generators map[uint]TraitGenerator // Trait generators by version
+
+	migrations map[uint64]Migration // Store migrations by the version they migrate from
+
+	hooks types.CollectablesHooks // Hooks of the modules reacting to the lifecycle of NFTs
 }
 
 // NewKeeper creates new instances of the nft Keeper
-func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey) Keeper {
-	return Keeper{
-		storeKey: storeKey,
-		cdc:      cdc,
+func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, paramSpace params.Subspace) Keeper {
+	k := Keeper{
+		storeKey:   storeKey,
+		cdc:        cdc,
+		paramSpace: paramSpace.WithKeyTable(types.ParamKeyTable()),
+		generators: make(map[uint]TraitGenerator),
+		migrations: make(map[uint64]Migration),
 	}
+	k.RegisterTraitGenerator(TraitGeneratorV1{})
+	k.RegisterMigration(1, k.MigrateV1ToV2)
+	k.RegisterMigration(2, k.MigrateV2ToV3)
+	k.RegisterMigration(3, k.MigrateV3ToV4)
+	k.RegisterMigration(4, k.MigrateV4ToV5)
+	return k
 }
 
 // Logger returns a module-specific logger.
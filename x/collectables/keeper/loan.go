@@ -0,0 +1,203 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/tosch110/collectables/x/collectables/types"
+)
+
+// GetNextLoanID returns the ID the next loan request will get
+func (k Keeper) GetNextLoanID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.NextLoanIDKey)
+	if bz == nil {
+		return 1
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetNextLoanID sets the ID the next loan request will get
+func (k Keeper) SetNextLoanID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.NextLoanIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetLoan returns a loan from its ID
+func (k Keeper) GetLoan(ctx sdk.Context, id uint64) (loan types.Loan, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetLoanKey(id))
+	if bz == nil {
+		return loan, false
+	}
+	loan = types.MustUnmarshalLoan(bz)
+	return loan, true
+}
+
+// SetLoan stores a loan and indexes it by its due height once it is funded
+func (k Keeper) SetLoan(ctx sdk.Context, loan types.Loan) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetLoanKey(loan.ID), types.MustMarshalLoan(loan))
+	if loan.IsFunded() {
+		store.Set(types.GetLoanDueKey(loan.DueHeight, loan.ID), sdk.Uint64ToBigEndian(loan.ID))
+	}
+}
+
+// DeleteLoan removes a loan and its due height index
+func (k Keeper) DeleteLoan(ctx sdk.Context, loan types.Loan) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetLoanKey(loan.ID))
+	if loan.IsFunded() {
+		store.Delete(types.GetLoanDueKey(loan.DueHeight, loan.ID))
+	}
+}
+
+// IterateLoans iterates over all the loans and performs a function
+func (k Keeper) IterateLoans(ctx sdk.Context, handler func(loan types.Loan) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.LoansKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		loan := types.MustUnmarshalLoan(iterator.Value())
+		if handler(loan) {
+			break
+		}
+	}
+}
+
+// GetLoans returns all the loans
+func (k Keeper) GetLoans(ctx sdk.Context) (loans types.Loans) {
+	k.IterateLoans(ctx, func(loan types.Loan) bool {
+		loans = append(loans, loan)
+		return false
+	})
+	return
+}
+
+// IterateDueLoans iterates over the funded loans due at or before a block height and performs a function
+func (k Keeper) IterateDueLoans(ctx sdk.Context, height int64, handler func(id uint64) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.LoanDuesKeyPrefix, sdk.PrefixEndBytes(types.GetLoanDuesKey(height)))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if handler(binary.BigEndian.Uint64(iterator.Value())) {
+			break
+		}
+	}
+}
+
+// RequestLoan locks an NFT of the borrower in escrow and opens a loan request for it
+func (k Keeper) RequestLoan(ctx sdk.Context, borrower sdk.AccAddress, denom, id string,
+	principal, interest sdk.Coins, duration int64) (types.Loan, error) {
+	nft, err := k.GetNFT(ctx, denom, id)
+	if err != nil {
+		return types.Loan{}, err
+	}
+	if !nft.GetOwner().Equals(borrower) {
+		return types.Loan{}, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("%s is not the owner of NFT #%s", borrower, id))
+	}
+	if err := types.CheckTransferable(nft); err != nil {
+		return types.Loan{}, err
+	}
+
+	loan := types.NewLoan(k.GetNextLoanID(ctx), borrower, denom, id, principal, interest, duration)
+	if err := loan.Validate(); err != nil {
+		return types.Loan{}, err
+	}
+
+	// the collateral can't stay listed for sale while it is escrowed
+	nft.SetOwner(types.EscrowAddress)
+	nft.EditPrice(sdk.NewCoins())
+	if err := k.UpdateNFT(ctx, denom, nft); err != nil {
+		return types.Loan{}, err
+	}
+
+	k.SetLoan(ctx, loan)
+	k.SetNextLoanID(ctx, loan.ID+1)
+	return loan, nil
+}
+
+// CancelLoan closes a loan request that wasn't funded and gives the collateral back to the borrower
+func (k Keeper) CancelLoan(ctx sdk.Context, loan types.Loan) error {
+	if loan.IsFunded() {
+		return sdkerrors.Wrap(types.ErrInvalidLoan, fmt.Sprintf("loan %d is already funded", loan.ID))
+	}
+	return k.closeLoan(ctx, loan, loan.Borrower)
+}
+
+// FundLoan sends the principal of a loan from the lender to the borrower and starts the loan
+func (k Keeper) FundLoan(ctx sdk.Context, loan types.Loan, lender sdk.AccAddress) (types.Loan, error) {
+	if loan.IsFunded() {
+		return loan, sdkerrors.Wrap(types.ErrInvalidLoan, fmt.Sprintf("loan %d is already funded", loan.ID))
+	}
+	if err := k.CoinKeeper.SendCoins(ctx, lender, loan.Borrower, loan.Principal); err != nil {
+		return loan, err
+	}
+
+	loan.Lender = lender
+	loan.DueHeight = ctx.BlockHeight() + loan.Duration
+	k.SetLoan(ctx, loan)
+	return loan, nil
+}
+
+// RepayLoan sends the principal and the interest from the borrower to the lender and gives
+// the collateral back to the borrower
+func (k Keeper) RepayLoan(ctx sdk.Context, loan types.Loan) error {
+	if !loan.IsFunded() {
+		return sdkerrors.Wrap(types.ErrInvalidLoan, fmt.Sprintf("loan %d isn't funded", loan.ID))
+	}
+	if err := k.CoinKeeper.SendCoins(ctx, loan.Borrower, loan.Lender, loan.Repayment()); err != nil {
+		return err
+	}
+	return k.closeLoan(ctx, loan, loan.Borrower)
+}
+
+// LiquidateDueLoans gives the collateral of every funded loan due at or before the current
+// block height to its lender
+func (k Keeper) LiquidateDueLoans(ctx sdk.Context) {
+	var due []uint64
+	k.IterateDueLoans(ctx, ctx.BlockHeight(), func(id uint64) (stop bool) {
+		due = append(due, id)
+		return false
+	})
+
+	for _, id := range due {
+		loan, found := k.GetLoan(ctx, id)
+		if !found {
+			continue
+		}
+		if err := k.closeLoan(ctx, loan, loan.Lender); err != nil {
+			// the collateral is gone, only the loan is left and is removed so it isn't visited again
+			k.Logger(ctx).Error(fmt.Sprintf("collateral of loan %d can't be liquidated: %s", loan.ID, err))
+			k.DeleteLoan(ctx, loan)
+			continue
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeLiquidateLoan,
+				sdk.NewAttribute(types.AttributeKeyLoanID, fmt.Sprintf("%d", loan.ID)),
+				sdk.NewAttribute(types.AttributeKeyDenom, loan.Denom),
+				sdk.NewAttribute(types.AttributeKeyNFTID, loan.NFTID),
+				sdk.NewAttribute(types.AttributeKeyLender, loan.Lender.String()),
+			),
+		)
+	}
+}
+
+// closeLoan releases the collateral of a loan from escrow to the recipient and deletes the loan
+func (k Keeper) closeLoan(ctx sdk.Context, loan types.Loan, recipient sdk.AccAddress) error {
+	nft, err := k.GetNFT(ctx, loan.Denom, loan.NFTID)
+	if err != nil {
+		return err
+	}
+	nft.SetOwner(recipient)
+	if err := k.UpdateNFT(ctx, loan.Denom, nft); err != nil {
+		return err
+	}
+	k.DeleteLoan(ctx, loan)
+	return nil
+}
@@ -0,0 +1,228 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/tosch110/collectables/x/collectables/types"
+)
+
+// Migration migrates the store in place from a version of its layout to the next one
+type Migration func(ctx sdk.Context) error
+
+// RegisterMigration adds the migration of the store from a version to the next one; it panics if it is already registered
+func (k Keeper) RegisterMigration(from uint64, migration Migration) {
+	if from == 0 {
+		panic("store version must be positive")
+	}
+	if _, ok := k.migrations[from]; ok {
+		panic(fmt.Sprintf("store migration from v%d already registered", from))
+	}
+	k.migrations[from] = migration
+}
+
+// GetStoreVersion returns the version of the store layout; stores written before it was recorded are v1
+func (k Keeper) GetStoreVersion(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.StoreVersionKey)
+	if bz == nil {
+		return 1
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetStoreVersion sets the version of the store layout
+func (k Keeper) SetStoreVersion(ctx sdk.Context, version uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.StoreVersionKey, sdk.Uint64ToBigEndian(version))
+}
+
+// RunMigrations migrates the store from its current version up to the consensus version,
+// one version at a time
+func (k Keeper) RunMigrations(ctx sdk.Context) error {
+	version := k.GetStoreVersion(ctx)
+	if version > types.ConsensusVersion {
+		return sdkerrors.Wrap(types.ErrUnknownMigration,
+			fmt.Sprintf("store v%d is newer than the consensus version v%d", version, types.ConsensusVersion),
+		)
+	}
+	for ; version < types.ConsensusVersion; version++ {
+		migration, ok := k.migrations[version]
+		if !ok {
+			return sdkerrors.Wrap(types.ErrUnknownMigration, fmt.Sprintf("no migration from store v%d", version))
+		}
+		if err := migration(ctx); err != nil {
+			return sdkerrors.Wrap(err, fmt.Sprintf("migrating store from v%d", version))
+		}
+		k.SetStoreVersion(ctx, version+1)
+		k.Logger(ctx).Info(fmt.Sprintf("migrated store from v%d to v%d", version, version+1))
+	}
+	return nil
+}
+
+// MigrateV1ToV2 removes the empty owner records that v1 kept once an address no longer held
+// any NFT of a denom and builds the indexes of the NFTs minted before v2 from scratch
+func (k Keeper) MigrateV1ToV2(ctx sdk.Context) error {
+	var emptyKeys [][]byte
+	k.iterateAminoRecords(ctx, types.OwnersKeyPrefix, func(key, bz []byte) {
+		var idCollection types.IDCollection
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &idCollection)
+		if idCollection.Supply() == 0 {
+			emptyKeys = append(emptyKeys, key)
+		}
+	})
+	store := ctx.KVStore(k.storeKey)
+	for _, key := range emptyKeys {
+		store.Delete(key)
+	}
+
+	k.reindexNFTs(ctx, k.getAminoCollections(ctx))
+	return nil
+}
+
+// reindexNFTs rebuilds the indexes derived from the NFTs of the collections from scratch
+func (k Keeper) reindexNFTs(ctx sdk.Context, collections []types.Collection) {
+	for _, prefix := range [][]byte{
+		types.HashesKeyPrefix, types.TraitCountsKeyPrefix, types.TraitsKeyPrefix,
+		types.RentalsKeyPrefix, types.EquipmentKeyPrefix, types.CreatorsKeyPrefix,
+	} {
+		k.deletePrefix(ctx, prefix)
+	}
+	for _, collection := range collections {
+		for _, nft := range collection.NFTs {
+			k.IndexNFT(ctx, collection.Denom, nft)
+		}
+	}
+}
+
+// deletePrefix deletes all the keys under a prefix
+func (k Keeper) deletePrefix(ctx sdk.Context, prefix []byte) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// MigrateV2ToV3 sets the default parameters introduced by v3, the history of NFTs starts empty
+func (k Keeper) MigrateV2ToV3(ctx sdk.Context) error {
+	k.SetParams(ctx, types.DefaultParams())
+	return nil
+}
+
+// MigrateV3ToV4 indexes the owners of the NFTs and the accounts found in their history as their
+// holders. The creators of the NFTs minted before v4 weren't recorded and aren't indexed.
+func (k Keeper) MigrateV3ToV4(ctx sdk.Context) error {
+	// the holders index of v4 is encoded with amino like the rest of the store
+	store := ctx.KVStore(k.storeKey)
+	setHeld := func(holder sdk.AccAddress, ref types.NFTRef) {
+		store.Set(types.GetHeldKey(holder, ref), k.cdc.MustMarshalBinaryLengthPrefixed(ref))
+	}
+
+	for _, holder := range types.DeriveHolders(k.getAminoCollections(ctx)) {
+		for _, ref := range holder.NFTs {
+			setHeld(holder.Address, ref)
+		}
+	}
+	k.iterateAminoRecords(ctx, types.HistoryKeyPrefix, func(_, bz []byte) {
+		var entry types.HistoryEntry
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &entry)
+		ref := types.NewNFTRef(entry.Denom, entry.ID)
+		for _, address := range []sdk.AccAddress{entry.From, entry.To} {
+			if !address.Empty() {
+				setHeld(address, ref)
+			}
+		}
+	})
+	return nil
+}
+
+// MigrateV4ToV5 re-encodes the records stored with amino before v5 with protobuf, addresses included
+// as bytes. The indexes derived from the NFTs are rebuilt from the migrated collections.
+func (k Keeper) MigrateV4ToV5(ctx sdk.Context) error {
+	k.migrateRecords(ctx, types.CollectionsKeyPrefix, func(bz []byte) []byte {
+		var collection types.Collection
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &collection)
+		return types.MustMarshalCollection(collection)
+	})
+	k.migrateRecords(ctx, types.OwnersKeyPrefix, func(bz []byte) []byte {
+		var idCollection types.IDCollection
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &idCollection)
+		return types.MustMarshalIDCollection(idCollection)
+	})
+	k.migrateRecords(ctx, types.HoldersKeyPrefix, func(bz []byte) []byte {
+		var ref types.NFTRef
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &ref)
+		return types.MustMarshalNFTRef(ref)
+	})
+	k.migrateRecords(ctx, types.HistoryKeyPrefix, func(bz []byte) []byte {
+		var entry types.HistoryEntry
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &entry)
+		return types.MustMarshalHistoryEntry(entry)
+	})
+	k.migrateRecords(ctx, types.LoansKeyPrefix, func(bz []byte) []byte {
+		var loan types.Loan
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &loan)
+		return types.MustMarshalLoan(loan)
+	})
+	k.migrateRecords(ctx, types.VaultsKeyPrefix, func(bz []byte) []byte {
+		var vault types.Vault
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &vault)
+		return types.MustMarshalVault(vault)
+	})
+	k.migrateRecords(ctx, types.BundlesKeyPrefix, func(bz []byte) []byte {
+		var bundle types.Bundle
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &bundle)
+		return types.MustMarshalBundle(bundle)
+	})
+	k.migrateRecords(ctx, types.SwapsKeyPrefix, func(bz []byte) []byte {
+		var swap types.Swap
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &swap)
+		return types.MustMarshalSwap(swap)
+	})
+
+	k.reindexNFTs(ctx, k.GetCollections(ctx))
+	return nil
+}
+
+// iterateAminoRecords iterates over the records under a prefix of a store older than v5, which encoded
+// them with amino, and performs a function with the key and the value of each of them
+func (k Keeper) iterateAminoRecords(ctx sdk.Context, prefix []byte, handler func(key, bz []byte)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		handler(iterator.Key(), iterator.Value())
+	}
+}
+
+// migrateRecords replaces the value of each record under a prefix by its migration
+func (k Keeper) migrateRecords(ctx sdk.Context, prefix []byte, migrate func(bz []byte) []byte) {
+	var keys, values [][]byte
+	k.iterateAminoRecords(ctx, prefix, func(key, bz []byte) {
+		keys = append(keys, key)
+		values = append(values, migrate(bz))
+	})
+	store := ctx.KVStore(k.storeKey)
+	for i, key := range keys {
+		store.Set(key, values[i])
+	}
+}
+
+// getAminoCollections returns all the collections of a store older than v5, which encoded them with amino
+func (k Keeper) getAminoCollections(ctx sdk.Context) (collections []types.Collection) {
+	k.iterateAminoRecords(ctx, types.CollectionsKeyPrefix, func(_, bz []byte) {
+		var collection types.Collection
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &collection)
+		collections = append(collections, collection)
+	})
+	return
+}
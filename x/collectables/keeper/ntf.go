@@ -39,12 +39,22 @@ func (k Keeper) UpdateNFT(ctx sdk.Context, denom string, nft types.NFT) (err err
 	if err != nil {
 		return err
 	}
+	// the hash and the proof are fixed at mint time
+	if oldNFT.GetHash() != nft.GetHash() || oldNFT.GetProof() != nft.GetProof() {
+		return sdkerrors.Wrap(types.ErrImmutableField, fmt.Sprintf("hash and proof of NFT #%s can't be changed", nft.GetID()))
+	}
+	if !oldNFT.GetCreator().Equals(nft.GetCreator()) {
+		return sdkerrors.Wrap(types.ErrImmutableField, fmt.Sprintf("creator of NFT #%s can't be changed", nft.GetID()))
+	}
 	// if the owner changed then update the owners KVStore too
-	if !oldNFT.GetOwner().Equals(nft.GetOwner()) {
+	transferred := !oldNFT.GetOwner().Equals(nft.GetOwner())
+	if transferred {
+		k.BeforeTransfer(ctx, denom, nft.GetID(), oldNFT.GetOwner(), nft.GetOwner())
 		err = k.SwapOwners(ctx, denom, nft.GetID(), oldNFT.GetOwner(), nft.GetOwner())
 		if err != nil {
 			return err
 		}
+		k.SetHeld(ctx, nft.GetOwner(), types.NewNFTRef(denom, nft.GetID()))
 	}
 	collection, err = collection.UpdateNFT(nft)
 
@@ -52,25 +62,66 @@ func (k Keeper) UpdateNFT(ctx sdk.Context, denom string, nft types.NFT) (err err
 		return err
 	}
 	k.SetCollection(ctx, denom, collection)
+	k.UnindexTraits(ctx, denom, oldNFT)
+	k.IndexTraits(ctx, denom, nft)
+	if transferred {
+		k.AfterTransfer(ctx, denom, nft.GetID(), oldNFT.GetOwner(), nft.GetOwner())
+	}
 	return nil
 }
 
+// IndexNFT indexes an NFT of a collection by its hash, its traits, its rental expiry, the parent
+// it is equipped onto and its creator
+func (k Keeper) IndexNFT(ctx sdk.Context, denom string, nft types.NFT) {
+	ref := types.NewNFTRef(denom, nft.GetID())
+	k.SetHash(ctx, denom, nft.GetHash(), nft.GetID())
+	k.IndexTraits(ctx, denom, nft)
+	if types.IsRented(nft) {
+		k.SetRentalExpiry(ctx, denom, nft.GetID(), nft.GetUserExpires())
+	}
+	if types.IsEquipped(nft) {
+		k.SetEquipped(ctx, nft.GetParent(), ref)
+	}
+	if !nft.GetCreator().Empty() {
+		k.SetCreated(ctx, nft.GetCreator(), ref)
+	}
+}
+
 // MintNFT mints an NFT and manages that NFTs existence within Collections and Owners
 func (k Keeper) MintNFT(ctx sdk.Context, denom string, nft types.NFT) (err error) {
 	collection, found := k.GetCollection(ctx, denom)
-	if found {
-		collection, err = collection.AddNFT(nft)
-		if err != nil {
-			return err
-		}
-	} else {
-		collection = types.NewCollection(denom, types.NewNFTs(nft))
+	if !found {
+		collection = types.NewCollection(denom, types.NewNFTs())
+		collection.GeneratorVersion = k.LatestGeneratorVersion()
+	}
+	stats, err := k.GenerateStats(collection.GeneratorVersion, nft.GetHash())
+	if err != nil {
+		return err
+	}
+	nft.SetStats(stats)
+	// a token can't be less restricted than its collection
+	nft.SetTransferPolicy(collection.TransferPolicy.Stricter(nft.GetTransferPolicy()))
+	collection, err = collection.AddNFT(nft)
+	if err != nil {
+		return err
+	}
+	if k.HasHash(ctx, denom, nft.GetHash()) {
+		return sdkerrors.Wrap(types.ErrHashAlreadyExists,
+			fmt.Sprintf("hash %s already exists in collection %s", nft.GetHash(), denom),
+		)
 	}
 	k.SetCollection(ctx, denom, collection)
+	k.SetHash(ctx, denom, nft.GetHash(), nft.GetID())
+	k.IndexTraits(ctx, denom, nft)
 
 	ownerIDCollection, _ := k.GetOwnerByDenom(ctx, nft.GetOwner(), denom)
 	ownerIDCollection = ownerIDCollection.AddID(nft.GetID())
 	k.SetOwnerByDenom(ctx, nft.GetOwner(), denom, ownerIDCollection.IDs)
+	k.SetHeld(ctx, nft.GetOwner(), types.NewNFTRef(denom, nft.GetID()))
+	if !nft.GetCreator().Empty() {
+		k.SetCreated(ctx, nft.GetCreator(), types.NewNFTRef(denom, nft.GetID()))
+	}
+	k.AfterMint(ctx, denom, nft.GetID(), nft.GetOwner())
 	return
 }
 
@@ -84,6 +135,9 @@ func (k Keeper) DeleteNFT(ctx sdk.Context, denom, id string) (err error) {
 	if err != nil {
 		return err
 	}
+	if k.HasEquipment(ctx, types.NewNFTRef(denom, id)) {
+		return sdkerrors.Wrap(types.ErrInvalidEquipment, fmt.Sprintf("NFT %s/%s has NFTs equipped", denom, id))
+	}
 	ownerIDCollection, found := k.GetOwnerByDenom(ctx, nft.GetOwner(), denom)
 	if !found {
 		return sdkerrors.Wrap(types.ErrUnknownCollection,
@@ -102,6 +156,12 @@ func (k Keeper) DeleteNFT(ctx sdk.Context, denom, id string) (err error) {
 	}
 
 	k.SetCollection(ctx, denom, collection)
+	k.DeleteHash(ctx, denom, nft.GetHash())
+	k.UnindexTraits(ctx, denom, nft)
+	if !nft.GetCreator().Empty() {
+		k.DeleteCreated(ctx, nft.GetCreator(), types.NewNFTRef(denom, id))
+	}
+	k.AfterBurn(ctx, denom, id, nft.GetOwner())
 
 	return
 }
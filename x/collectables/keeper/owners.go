@@ -42,20 +42,25 @@ func (k Keeper) GetOwnerByDenom(ctx sdk.Context, owner sdk.AccAddress, denom str
 	if b == nil {
 		return types.NewIDCollection(denom, []string{}), false
 	}
-	k.cdc.MustUnmarshalBinaryLengthPrefixed(b, &idCollection)
+	idCollection = types.MustUnmarshalIDCollection(b)
 	return idCollection, true
 }
 
-// SetOwnerByDenom sets a collection of NFT IDs owned by an address
+// SetOwnerByDenom sets a collection of NFT IDs owned by an address, the record is removed
+// once the address doesn't own any NFT of the denom
 func (k Keeper) SetOwnerByDenom(ctx sdk.Context, owner sdk.AccAddress, denom string, ids []string) {
 	store := ctx.KVStore(k.storeKey)
 	key := types.GetOwnerKey(owner, denom)
+	if len(ids) == 0 {
+		store.Delete(key)
+		return
+	}
 
 	var idCollection types.IDCollection
 	idCollection.Denom = denom
 	idCollection.IDs = ids
 
-	store.Set(key, k.cdc.MustMarshalBinaryLengthPrefixed(idCollection))
+	store.Set(key, types.MustMarshalIDCollection(idCollection))
 }
 
 // SetOwner sets an entire Owner
@@ -79,8 +84,7 @@ func (k Keeper) IterateIDCollections(ctx sdk.Context, prefix []byte,
 	iterator := sdk.KVStorePrefixIterator(store, prefix)
 	defer iterator.Close()
 	for ; iterator.Valid(); iterator.Next() {
-		var idCollection types.IDCollection
-		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &idCollection)
+		idCollection := types.MustUnmarshalIDCollection(iterator.Value())
 
 		owner, _ := types.SplitOwnerKey(iterator.Key())
 		if handler(owner, idCollection) {
@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tosch110/collectables/x/collectables/types"
+)
+
+// GetParams returns the parameters of the module
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the parameters of the module
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// HistoryLimit returns the number of history entries kept per NFT
+func (k Keeper) HistoryLimit(ctx sdk.Context) (limit uint64) {
+	k.paramSpace.Get(ctx, types.KeyHistoryLimit, &limit)
+	return
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/binary"
 	"fmt"
 
+	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -20,6 +21,23 @@ const (
 	QueryCollection   = "collection"
 	QueryDenoms       = "denoms"
 	QueryNFT          = "nft"
+	QueryByHash       = "byHash"
+	QuerySearch       = "search"
+	QueryRarity       = "rarity"
+	QueryRarityRank   = "rarityRank"
+	QueryReveal       = "reveal"
+	QueryLoan         = "loan"
+	QueryLoans        = "loans"
+	QueryVault        = "vault"
+	QueryVaults       = "vaults"
+	QueryBundle       = "bundle"
+	QuerySwap         = "swap"
+	QuerySwaps        = "swaps"
+	QueryEquipment    = "equipment"
+	QueryHistory      = "history"
+	QueryCreatedBy    = "createdBy"
+	QueryHeldBy       = "heldBy"
+	QueryGRPC         = "grpc" // followed by the method of the Query service, queried with its protobuf encoded request
 )
 
 // NewQuerier is the module level router for state queries
@@ -38,6 +56,40 @@ func NewQuerier(k Keeper) sdk.Querier {
 			return queryDenoms(ctx, path[1:], req, k)
 		case QueryNFT:
 			return queryNFT(ctx, path[1:], req, k)
+		case QueryByHash:
+			return queryByHash(ctx, path[1:], req, k)
+		case QuerySearch:
+			return querySearch(ctx, path[1:], req, k)
+		case QueryRarity:
+			return queryRarity(ctx, path[1:], req, k)
+		case QueryRarityRank:
+			return queryRarityRank(ctx, path[1:], req, k)
+		case QueryReveal:
+			return queryReveal(ctx, path[1:], req, k)
+		case QueryLoan:
+			return queryLoan(ctx, path[1:], req, k)
+		case QueryLoans:
+			return queryLoans(ctx, path[1:], req, k)
+		case QueryVault:
+			return queryVault(ctx, path[1:], req, k)
+		case QueryVaults:
+			return queryVaults(ctx, path[1:], req, k)
+		case QueryBundle:
+			return queryBundle(ctx, path[1:], req, k)
+		case QuerySwap:
+			return querySwap(ctx, path[1:], req, k)
+		case QuerySwaps:
+			return querySwaps(ctx, path[1:], req, k)
+		case QueryEquipment:
+			return queryEquipment(ctx, path[1:], req, k)
+		case QueryHistory:
+			return queryHistory(ctx, path[1:], req, k)
+		case QueryCreatedBy:
+			return queryCreatedBy(ctx, path[1:], req, k)
+		case QueryHeldBy:
+			return queryHeldBy(ctx, path[1:], req, k)
+		case QueryGRPC:
+			return queryGRPC(ctx, path[1:], req, k)
 		default:
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "unknown nft query endpoint")
 		}
@@ -155,3 +207,359 @@ func queryNFT(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper) (
 
 	return bz, nil
 }
+
+func queryByHash(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryHashParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, err.Error())
+	}
+
+	refs := k.GetNFTRefsByHash(ctx, params.Hash)
+	if len(refs) == 0 {
+		return nil, sdkerrors.Wrap(types.ErrUnknownNFT, fmt.Sprintf("no NFT minted with hash %s", params.Hash))
+	}
+
+	bz, err := types.ModuleCdc.MarshalJSON(refs)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func querySearch(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QuerySearchParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, err.Error())
+	}
+
+	collections := k.SearchNFTs(ctx, params.Denom, params.Traits)
+
+	bz, err := types.ModuleCdc.MarshalJSON(collections)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryRarity(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryNFTParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, err.Error())
+	}
+
+	rarity, err := k.GetRarity(ctx, params.Denom, params.TokenID)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := types.ModuleCdc.MarshalJSON(rarity)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryRarityRank(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryCollectionParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, err.Error())
+	}
+
+	rarities, err := k.GetRarityRanking(ctx, params.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := types.ModuleCdc.MarshalJSON(rarities)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryReveal(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryCollectionParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, err.Error())
+	}
+
+	status, err := k.GetRevealStatus(ctx, params.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := types.ModuleCdc.MarshalJSON(status)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryLoan(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryLoanParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, err.Error())
+	}
+
+	loan, found := k.GetLoan(ctx, params.ID)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrUnknownLoan, fmt.Sprintf("loan %d doesn't exist", params.ID))
+	}
+
+	bz, err := types.ModuleCdc.MarshalJSON(loan)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryLoans(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryLoansParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, err.Error())
+	}
+
+	loans := types.Loans{}
+	k.IterateLoans(ctx, func(loan types.Loan) bool {
+		if !params.Borrower.Empty() && !params.Borrower.Equals(loan.Borrower) {
+			return false
+		}
+		if !params.Lender.Empty() && !params.Lender.Equals(loan.Lender) {
+			return false
+		}
+		loans = append(loans, loan)
+		return false
+	})
+
+	bz, err := types.ModuleCdc.MarshalJSON(loans)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryVault(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryNFTParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, err.Error())
+	}
+
+	vault, found := k.GetVault(ctx, params.Denom, params.TokenID)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrUnknownVault, fmt.Sprintf("NFT #%s of %s isn't fractionalized", params.TokenID, params.Denom))
+	}
+
+	bz, err := types.ModuleCdc.MarshalJSON(vault)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryVaults(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryVaultsParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, err.Error())
+	}
+
+	vaults := types.Vaults{}
+	k.IterateVaults(ctx, func(vault types.Vault) bool {
+		if params.Denom == "" || params.Denom == vault.Denom {
+			vaults = append(vaults, vault)
+		}
+		return false
+	})
+
+	bz, err := types.ModuleCdc.MarshalJSON(vaults)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryBundle(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryBundleParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, err.Error())
+	}
+
+	bundle, found := k.GetBundle(ctx, params.ID)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrUnknownBundle, fmt.Sprintf("bundle %s doesn't exist", params.ID))
+	}
+
+	bz, err := types.ModuleCdc.MarshalJSON(bundle)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func querySwap(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QuerySwapParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, err.Error())
+	}
+
+	swap, found := k.GetSwap(ctx, params.ID)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrUnknownSwap, fmt.Sprintf("swap %d doesn't exist", params.ID))
+	}
+
+	bz, err := types.ModuleCdc.MarshalJSON(swap)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func querySwaps(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QuerySwapsParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, err.Error())
+	}
+
+	swaps := types.Swaps{}
+	k.IterateSwaps(ctx, func(swap types.Swap) bool {
+		if !params.Proposer.Empty() && !params.Proposer.Equals(swap.Proposer) {
+			return false
+		}
+		if !params.Counterparty.Empty() && !params.Counterparty.Equals(swap.Counterparty) {
+			return false
+		}
+		swaps = append(swaps, swap)
+		return false
+	})
+
+	bz, err := types.ModuleCdc.MarshalJSON(swaps)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryEquipment(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryNFTParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, err.Error())
+	}
+
+	nft, err := k.GetNFT(ctx, params.Denom, params.TokenID)
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := k.GetEquippedStats(ctx, params.Denom, nft)
+	if err != nil {
+		return nil, err
+	}
+
+	children := k.GetEquipment(ctx, types.NewNFTRef(params.Denom, params.TokenID))
+	bz, err := types.ModuleCdc.MarshalJSON(types.NewEquipment(params.Denom, params.TokenID, children, stats))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryHistory(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryHistoryParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, err.Error())
+	}
+
+	history := k.GetHistory(ctx, params.Denom, params.TokenID)
+	start, end := client.Paginate(len(history), params.Page, params.Limit, int(types.DefaultHistoryLimit))
+	if start < 0 || end < 0 {
+		history = types.History{}
+	} else {
+		history = history[start:end]
+	}
+
+	bz, err := types.ModuleCdc.MarshalJSON(history)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryCreatedBy(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryAddressParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, err.Error())
+	}
+
+	return marshalRefsPage(k.GetCreatedBy(ctx, params.Address), params.Page, params.Limit)
+}
+
+func queryHeldBy(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryAddressParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, err.Error())
+	}
+
+	return marshalRefsPage(k.GetHeldBy(ctx, params.Address), params.Page, params.Limit)
+}
+
+// marshalRefsPage marshals a page of NFT references
+func marshalRefsPage(refs []types.NFTRef, page, limit int) ([]byte, error) {
+	start, end := client.Paginate(len(refs), page, limit, types.DefaultQueryLimit)
+	if start < 0 || end < 0 {
+		refs = []types.NFTRef{}
+	} else {
+		refs = refs[start:end]
+	}
+
+	bz, err := types.ModuleCdc.MarshalJSON(refs)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/tosch110/collectables/x/collectables/types"
+)
+
+// GetTraitCount returns the number of NFTs of a collection having a trait value
+func (k Keeper) GetTraitCount(ctx sdk.Context, denom string, trait types.Trait) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetTraitCountKey(denom, trait))
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k Keeper) setTraitCount(ctx sdk.Context, denom string, trait types.Trait, count uint64) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetTraitCountKey(denom, trait)
+	if count == 0 {
+		store.Delete(key)
+		return
+	}
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, count)
+	store.Set(key, bz)
+}
+
+// IndexTraits counts the rarity traits of an NFT in its collection and indexes the NFT by each of them
+func (k Keeper) IndexTraits(ctx sdk.Context, denom string, nft types.NFT) {
+	store := ctx.KVStore(k.storeKey)
+	ref := types.MustMarshalNFTRef(types.NewNFTRef(denom, nft.GetID()))
+	for _, trait := range types.RarityTraits(nft) {
+		k.setTraitCount(ctx, denom, trait, k.GetTraitCount(ctx, denom, trait)+1)
+		store.Set(types.GetTraitNFTKey(denom, trait, nft.GetID()), ref)
+	}
+}
+
+// UnindexTraits removes the rarity traits of an NFT from the counts and the index of its collection
+func (k Keeper) UnindexTraits(ctx sdk.Context, denom string, nft types.NFT) {
+	store := ctx.KVStore(k.storeKey)
+	for _, trait := range types.RarityTraits(nft) {
+		count := k.GetTraitCount(ctx, denom, trait)
+		if count > 0 {
+			count--
+		}
+		k.setTraitCount(ctx, denom, trait, count)
+		store.Delete(types.GetTraitNFTKey(denom, trait, nft.GetID()))
+	}
+}
+
+// GetTraitNFTs returns the IDs of the NFTs of a collection indexed by a trait value
+func (k Keeper) GetTraitNFTs(ctx sdk.Context, denom string, trait types.Trait) (ids []string) {
+	for _, ref := range k.getRefs(ctx, types.GetTraitNFTsKey(denom, trait)) {
+		ids = append(ids, ref.ID)
+	}
+	return
+}
+
+// GetRarityRanking returns the rarity of every NFT of a collection, rarest first
+func (k Keeper) GetRarityRanking(ctx sdk.Context, denom string) (types.Rarities, error) {
+	collection, found := k.GetCollection(ctx, denom)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrUnknownCollection, fmt.Sprintf("collection of %s doesn't exist", denom))
+	}
+
+	supply := sdk.NewDec(int64(collection.Supply()))
+	rarities := make(types.Rarities, 0, len(collection.NFTs))
+	for _, nft := range collection.NFTs {
+		score := sdk.ZeroDec()
+		for _, trait := range types.RarityTraits(nft) {
+			if count := k.GetTraitCount(ctx, denom, trait); count > 0 {
+				score = score.Add(supply.QuoInt64(int64(count)))
+			}
+		}
+		rarities = append(rarities, types.NewRarity(denom, nft.GetID(), score))
+	}
+	return rarities.Rank(), nil
+}
+
+// GetRarity returns the rarity score and rank of an NFT within its collection
+func (k Keeper) GetRarity(ctx sdk.Context, denom, id string) (types.Rarity, error) {
+	rarities, err := k.GetRarityRanking(ctx, denom)
+	if err != nil {
+		return types.Rarity{}, err
+	}
+	for _, rarity := range rarities {
+		if rarity.ID == id {
+			return rarity, nil
+		}
+	}
+	return types.Rarity{}, sdkerrors.Wrap(types.ErrUnknownNFT, fmt.Sprintf("NFT #%s doesn't exist in collection %s", id, denom))
+}
@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/tosch110/collectables/x/collectables/types"
+)
+
+// RentNFT grants the usage rights of an NFT to a user until a block height
+func (k Keeper) RentNFT(ctx sdk.Context, denom string, nft types.NFT, user sdk.AccAddress, expires int64) error {
+	if types.IsRented(nft) {
+		return sdkerrors.Wrap(types.ErrRented, fmt.Sprintf("NFT #%s is rented until block %d", nft.GetID(), nft.GetUserExpires()))
+	}
+	if expires <= ctx.BlockHeight() {
+		return sdkerrors.Wrap(types.ErrInvalidRental, fmt.Sprintf("rental must end after block %d", ctx.BlockHeight()))
+	}
+
+	nft.SetUser(user, expires)
+	if err := k.UpdateNFT(ctx, denom, nft); err != nil {
+		return err
+	}
+	k.SetRentalExpiry(ctx, denom, nft.GetID(), expires)
+	return nil
+}
+
+// EndRental takes the usage rights of an NFT back from its user
+func (k Keeper) EndRental(ctx sdk.Context, denom string, nft types.NFT) error {
+	if !types.IsRented(nft) {
+		return nil
+	}
+	k.DeleteRentalExpiry(ctx, denom, nft.GetID(), nft.GetUserExpires())
+
+	user := nft.GetUser()
+	nft.SetUser(nil, 0)
+	if err := k.UpdateNFT(ctx, denom, nft); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeEndRental,
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyNFTID, nft.GetID()),
+			sdk.NewAttribute(types.AttributeKeyUser, user.String()),
+		),
+	)
+	return nil
+}
+
+// EndExpiredRentals ends all the rentals expiring at or before the current block height
+func (k Keeper) EndExpiredRentals(ctx sdk.Context) {
+	var expired []types.NFTRef
+	var expiries []int64
+	k.IterateExpiredRentals(ctx, ctx.BlockHeight(), func(expires int64, ref types.NFTRef) (stop bool) {
+		expired = append(expired, ref)
+		expiries = append(expiries, expires)
+		return false
+	})
+
+	for i, ref := range expired {
+		nft, err := k.GetNFT(ctx, ref.Denom, ref.ID)
+		if err != nil {
+			// the NFT is gone, only the index entry is left and is removed so it isn't visited again
+			k.Logger(ctx).Error(fmt.Sprintf("rented NFT %s doesn't exist", ref))
+			k.DeleteRentalExpiry(ctx, ref.Denom, ref.ID, expiries[i])
+			continue
+		}
+		if err := k.EndRental(ctx, ref.Denom, nft); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// IterateExpiredRentals iterates over the rentals expiring at or before a block height and performs a function
+// with the height each rental ends at
+func (k Keeper) IterateExpiredRentals(ctx sdk.Context, height int64, handler func(expires int64, ref types.NFTRef) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.RentalsKeyPrefix, sdk.PrefixEndBytes(types.GetRentalsKey(height)))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		ref := types.MustUnmarshalNFTRef(iterator.Value())
+		if handler(types.SplitRentalKey(iterator.Key()), ref) {
+			break
+		}
+	}
+}
+
+// SetRentalExpiry indexes a rented NFT by the block height its rental ends at
+func (k Keeper) SetRentalExpiry(ctx sdk.Context, denom, id string, expires int64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := types.MustMarshalNFTRef(types.NewNFTRef(denom, id))
+	store.Set(types.GetRentalKey(expires, denom, id), bz)
+}
+
+// DeleteRentalExpiry removes the rental expiry index of an NFT
+func (k Keeper) DeleteRentalExpiry(ctx sdk.Context, denom, id string, expires int64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetRentalKey(expires, denom, id))
+}
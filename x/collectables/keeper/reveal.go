@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/tosch110/collectables/x/collectables/types"
+)
+
+// GetRevealStatus returns whether the metadata of a collection has been revealed
+func (k Keeper) GetRevealStatus(ctx sdk.Context, denom string) (types.RevealStatus, error) {
+	collection, found := k.GetCollection(ctx, denom)
+	if !found {
+		return types.RevealStatus{}, sdkerrors.Wrap(types.ErrUnknownCollection, fmt.Sprintf("collection of %s doesn't exist", denom))
+	}
+	return types.NewRevealStatus(collection), nil
+}
+
+// RevealCollection replaces the placeholder metadata of the NFTs of a delayed reveal
+// collection with the final metadata, which has to match the commitment of the collection.
+func (k Keeper) RevealCollection(ctx sdk.Context, denom string, metadata types.RevealMetadata) error {
+	collection, found := k.GetCollection(ctx, denom)
+	if !found {
+		return sdkerrors.Wrap(types.ErrUnknownCollection, fmt.Sprintf("collection of %s doesn't exist", denom))
+	}
+	if collection.Commitment == "" {
+		return sdkerrors.Wrap(types.ErrInvalidCommitment, fmt.Sprintf("collection %s has no delayed reveal", denom))
+	}
+	if collection.Revealed {
+		return sdkerrors.Wrap(types.ErrAlreadyRevealed, fmt.Sprintf("collection %s is already revealed", denom))
+	}
+	if commitment := metadata.Commitment(); commitment != collection.Commitment {
+		return sdkerrors.Wrap(types.ErrRevealMismatch,
+			fmt.Sprintf("expected commitment %s, got %s", collection.Commitment, commitment),
+		)
+	}
+
+	// check everything before writing so a bad entry doesn't leave the collection half revealed
+	nfts := make(types.NFTs, len(metadata))
+	for i, m := range metadata {
+		nft, err := collection.GetNFT(m.ID)
+		if err != nil {
+			return err
+		}
+		if collection.Frozen || nft.IsFrozen() {
+			return sdkerrors.Wrap(types.ErrMetadataFrozen, fmt.Sprintf("NFT #%s is frozen", m.ID))
+		}
+		if err := collection.Schema.Check(m.Traits); err != nil {
+			return err
+		}
+		m = types.NewNFTMetadata(m.ID, m.Name, m.Description, m.TokenURI, m.Traits)
+		nft.EditMetadata(m.Name, m.Description, m.TokenURI, m.Traits)
+		nfts[i] = nft
+	}
+
+	for _, nft := range nfts {
+		if err := k.UpdateNFT(ctx, denom, nft); err != nil {
+			return err
+		}
+	}
+
+	collection, _ = k.GetCollection(ctx, denom)
+	collection.Revealed = true
+	k.SetCollection(ctx, denom, collection)
+	return nil
+}
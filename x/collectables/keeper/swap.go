@@ -0,0 +1,184 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/tosch110/collectables/x/collectables/types"
+)
+
+// GetNextSwapID returns the ID the next swap will get
+func (k Keeper) GetNextSwapID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.NextSwapIDKey)
+	if bz == nil {
+		return 1
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetNextSwapID sets the ID the next swap will get
+func (k Keeper) SetNextSwapID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.NextSwapIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetSwap returns a swap from its ID
+func (k Keeper) GetSwap(ctx sdk.Context, id uint64) (swap types.Swap, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetSwapKey(id))
+	if bz == nil {
+		return swap, false
+	}
+	swap = types.MustUnmarshalSwap(bz)
+	return swap, true
+}
+
+// SetSwap stores a swap
+func (k Keeper) SetSwap(ctx sdk.Context, swap types.Swap) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetSwapKey(swap.ID), types.MustMarshalSwap(swap))
+}
+
+// DeleteSwap removes a swap
+func (k Keeper) DeleteSwap(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetSwapKey(id))
+}
+
+// IterateSwaps iterates over all the swaps and performs a function
+func (k Keeper) IterateSwaps(ctx sdk.Context, handler func(swap types.Swap) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.SwapsKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		swap := types.MustUnmarshalSwap(iterator.Value())
+		if handler(swap) {
+			break
+		}
+	}
+}
+
+// GetSwaps returns all the swaps
+func (k Keeper) GetSwaps(ctx sdk.Context) (swaps types.Swaps) {
+	k.IterateSwaps(ctx, func(swap types.Swap) bool {
+		swaps = append(swaps, swap)
+		return false
+	})
+	return
+}
+
+// ProposeSwap locks the NFTs and the coins offered by the proposer in escrow until the
+// counterparty accepts the swap or the proposer cancels it
+func (k Keeper) ProposeSwap(ctx sdk.Context, proposer, counterparty sdk.AccAddress,
+	offeredNFTs []types.NFTRef, offeredCoins sdk.Coins,
+	requestedNFTs []types.NFTRef, requestedCoins sdk.Coins) (types.Swap, error) {
+	swap := types.NewSwap(k.GetNextSwapID(ctx), proposer, counterparty, offeredNFTs, offeredCoins, requestedNFTs, requestedCoins)
+	if err := swap.Validate(); err != nil {
+		return types.Swap{}, err
+	}
+
+	offered, err := k.getSwappableNFTs(ctx, proposer, offeredNFTs)
+	if err != nil {
+		return types.Swap{}, err
+	}
+	if !offeredCoins.IsZero() {
+		if err := k.SupplyKeeper.SendCoinsFromAccountToModule(ctx, proposer, types.ModuleName, offeredCoins); err != nil {
+			return types.Swap{}, err
+		}
+	}
+	if err := k.transferSwappedNFTs(ctx, offeredNFTs, offered, types.EscrowAddress); err != nil {
+		return types.Swap{}, err
+	}
+
+	k.SetSwap(ctx, swap)
+	k.SetNextSwapID(ctx, swap.ID+1)
+	return swap, nil
+}
+
+// AcceptSwap settles a swap: the requested NFTs and coins go from the counterparty to the
+// proposer and the escrowed NFTs and coins go to the counterparty, all or nothing
+func (k Keeper) AcceptSwap(ctx sdk.Context, swap types.Swap) error {
+	// the writes are only committed once both sides are settled, so a failure partway through
+	// leaves the swap open even when the caller doesn't revert them
+	cacheCtx, write := ctx.CacheContext()
+	requested, err := k.getSwappableNFTs(cacheCtx, swap.Counterparty, swap.RequestedNFTs)
+	if err != nil {
+		return err
+	}
+	if !swap.RequestedCoins.IsZero() {
+		if err := k.CoinKeeper.SendCoins(cacheCtx, swap.Counterparty, swap.Proposer, swap.RequestedCoins); err != nil {
+			return err
+		}
+	}
+	if err := k.transferSwappedNFTs(cacheCtx, swap.RequestedNFTs, requested, swap.Proposer); err != nil {
+		return err
+	}
+	if err := k.closeSwap(cacheCtx, swap, swap.Counterparty); err != nil {
+		return err
+	}
+	write()
+	return nil
+}
+
+// CancelSwap closes a swap and gives the escrowed NFTs and coins back to the proposer
+func (k Keeper) CancelSwap(ctx sdk.Context, swap types.Swap) error {
+	return k.closeSwap(ctx, swap, swap.Proposer)
+}
+
+// closeSwap releases the NFTs and the coins escrowed by a swap to the recipient and deletes the swap
+func (k Keeper) closeSwap(ctx sdk.Context, swap types.Swap, recipient sdk.AccAddress) error {
+	offered := make(types.NFTs, len(swap.OfferedNFTs))
+	for i, ref := range swap.OfferedNFTs {
+		nft, err := k.GetNFT(ctx, ref.Denom, ref.ID)
+		if err != nil {
+			return err
+		}
+		offered[i] = nft
+	}
+	if err := k.transferSwappedNFTs(ctx, swap.OfferedNFTs, offered, recipient); err != nil {
+		return err
+	}
+	if !swap.OfferedCoins.IsZero() {
+		if err := k.SupplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, swap.OfferedCoins); err != nil {
+			return err
+		}
+	}
+	k.DeleteSwap(ctx, swap.ID)
+	return nil
+}
+
+// getSwappableNFTs returns the NFTs of a side of a swap after checking they belong to the owner
+// and can change hands
+func (k Keeper) getSwappableNFTs(ctx sdk.Context, owner sdk.AccAddress, refs []types.NFTRef) (types.NFTs, error) {
+	nfts := make(types.NFTs, len(refs))
+	for i, ref := range refs {
+		nft, err := k.GetNFT(ctx, ref.Denom, ref.ID)
+		if err != nil {
+			return nil, err
+		}
+		if !nft.GetOwner().Equals(owner) {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("%s is not the owner of NFT %s", owner, ref))
+		}
+		if err := types.CheckTransferable(nft); err != nil {
+			return nil, err
+		}
+		nfts[i] = nft
+	}
+	return nfts, nil
+}
+
+// transferSwappedNFTs gives swapped NFTs to the recipient, listings don't survive the swap
+func (k Keeper) transferSwappedNFTs(ctx sdk.Context, refs []types.NFTRef, nfts types.NFTs, recipient sdk.AccAddress) error {
+	for i, nft := range nfts {
+		nft.SetOwner(recipient)
+		nft.EditPrice(sdk.NewCoins())
+		if err := k.UpdateNFT(ctx, refs[i].Denom, nft); err != nil {
+			return err
+		}
+	}
+	return nil
+}
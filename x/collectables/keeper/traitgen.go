@@ -0,0 +1,114 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+
+	"lukechampine.com/blake3"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/tosch110/collectables/x/collectables/types"
+)
+
+// TraitGenerator derives the game stats of an NFT from the bytes of its hash.
+// Generators must be deterministic and never change once registered; new
+// derivation rules are added as a new version so existing tokens keep their stats.
+type TraitGenerator interface {
+	Version() uint
+	Generate(hash []byte) types.Stats
+}
+
+// RegisterTraitGenerator adds a trait generator to the keeper; it panics if its version is already registered
+func (k Keeper) RegisterTraitGenerator(generator TraitGenerator) {
+	version := generator.Version()
+	if version == 0 {
+		panic("trait generator version must be positive")
+	}
+	if _, ok := k.generators[version]; ok {
+		panic(fmt.Sprintf("trait generator v%d already registered", version))
+	}
+	k.generators[version] = generator
+}
+
+// LatestGeneratorVersion returns the highest registered trait generator version
+func (k Keeper) LatestGeneratorVersion() (latest uint) {
+	for version := range k.generators {
+		if version > latest {
+			latest = version
+		}
+	}
+	return latest
+}
+
+// GenerateStats derives the stats of an NFT hash with a trait generator version;
+// collections created before generators were versioned use the first version
+func (k Keeper) GenerateStats(version uint, hash string) (types.Stats, error) {
+	if version == 0 {
+		version = 1
+	}
+	generator, ok := k.generators[version]
+	if !ok {
+		return types.Stats{}, sdkerrors.Wrap(types.ErrInvalidCollection, fmt.Sprintf("unknown trait generator v%d", version))
+	}
+	return generator.Generate(hashBytes(hash)), nil
+}
+
+// GetStats returns the stats of an NFT, deriving them for NFTs minted before stats existed
+func (k Keeper) GetStats(ctx sdk.Context, denom string, nft types.NFT) (types.Stats, error) {
+	if !nft.GetStats().Empty() {
+		return nft.GetStats(), nil
+	}
+	collection, found := k.GetCollection(ctx, denom)
+	if !found {
+		return types.Stats{}, sdkerrors.Wrap(types.ErrUnknownCollection, fmt.Sprintf("collection of %s doesn't exist", denom))
+	}
+	return k.GenerateStats(collection.GeneratorVersion, nft.GetHash())
+}
+
+// hashBytes decodes a hex hash, falling back to the blake3 digest of hashes that aren't hex
+func hashBytes(hash string) []byte {
+	bz, err := hex.DecodeString(hash)
+	if err != nil || len(bz) < 32 {
+		sum := blake3.Sum256([]byte(hash))
+		return sum[:]
+	}
+	return bz
+}
+
+// ----------------------------------------------------------------------------
+// Generators
+
+// TraitGeneratorV1 derives stats between 1 and 100 from the first bytes of the hash
+// and a rarity tier from the following byte
+type TraitGeneratorV1 struct{}
+
+var _ TraitGenerator = TraitGeneratorV1{}
+
+// Version implements TraitGenerator
+func (TraitGeneratorV1) Version() uint { return 1 }
+
+// Generate implements TraitGenerator
+func (g TraitGeneratorV1) Generate(hash []byte) types.Stats {
+	attack := uint(binary.BigEndian.Uint16(hash[0:2])%100) + 1
+	defense := uint(binary.BigEndian.Uint16(hash[2:4])%100) + 1
+	speed := uint(binary.BigEndian.Uint16(hash[4:6])%100) + 1
+
+	var rarity string
+	switch tier := hash[6]; {
+	case tier < 3:
+		rarity = types.RarityLegendary
+	case tier < 13:
+		rarity = types.RarityEpic
+	case tier < 51:
+		rarity = types.RarityRare
+	case tier < 128:
+		rarity = types.RarityUncommon
+	default:
+		rarity = types.RarityCommon
+	}
+
+	return types.NewStats(g.Version(), attack, defense, speed, rarity)
+}
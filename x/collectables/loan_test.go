@@ -0,0 +1,160 @@
+package collectables_test
+
+import (
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	nft "github.com/tosch110/collectables/x/collectables"
+)
+
+var (
+	principal = sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	interest  = sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+)
+
+// requestLoan mints the collateral of alice and requests a loan against it which runs for 5 blocks once funded
+func requestLoan(t *testing.T, ctx sdk.Context, k nft.Keeper, id string) nft.Loan {
+	mint(t, ctx, k, nft.FighterDenom, id, alice, nil)
+	loan, err := k.RequestLoan(ctx, alice, nft.FighterDenom, id, principal, interest, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return loan
+}
+
+// checkOwner fails the test unless the NFT is owned by the address
+func checkOwner(t *testing.T, ctx sdk.Context, k nft.Keeper, denom, id string, owner sdk.AccAddress) {
+	t.Helper()
+	token, err := k.GetNFT(ctx, denom, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !token.GetOwner().Equals(owner) {
+		t.Fatalf("expected NFT %s/%s to be owned by %s, got %s", denom, id, owner, token.GetOwner())
+	}
+}
+
+func TestLoanRepaidBeforeDue(t *testing.T) {
+	ctx, k, bankKeeper := createTestInputWithBank(t)
+	nft.InitGenesis(ctx, k, nft.DefaultGenesisState())
+	bankKeeper.SetCoins(ctx, alice, interest)
+	bankKeeper.SetCoins(ctx, bob, principal)
+
+	loan := requestLoan(t, ctx, k, "a")
+	checkOwner(t, ctx, k, nft.FighterDenom, "a", nft.EscrowAddress)
+	checkEscrow(t, ctx, k)
+
+	loan, err := k.FundLoan(ctx, loan, bob)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loan.DueHeight != 15 || !bankKeeper.GetCoins(ctx, alice).IsEqual(principal.Add(interest...)) {
+		t.Fatalf("expected a loan due at 15 and the principal lent to alice, got %v %s", loan, bankKeeper.GetCoins(ctx, alice))
+	}
+	checkEscrow(t, ctx, k)
+
+	// the borrower can't take the collateral back without repaying
+	if err := k.CancelLoan(ctx, loan); !errors.Is(err, nft.ErrInvalidLoan) {
+		t.Fatalf("expected %v, got %v", nft.ErrInvalidLoan, err)
+	}
+	checkOwner(t, ctx, k, nft.FighterDenom, "a", nft.EscrowAddress)
+
+	ctx = ctx.WithBlockHeight(14)
+	if err := k.RepayLoan(ctx, loan); err != nil {
+		t.Fatal(err)
+	}
+	checkOwner(t, ctx, k, nft.FighterDenom, "a", alice)
+	if _, found := k.GetLoan(ctx, loan.ID); found {
+		t.Fatal("expected the repaid loan to be closed")
+	}
+	if !bankKeeper.GetCoins(ctx, alice).IsZero() || !bankKeeper.GetCoins(ctx, bob).IsEqual(principal.Add(interest...)) {
+		t.Fatalf("expected bob to get the principal and the interest, got %s", bankKeeper.GetCoins(ctx, bob))
+	}
+	checkEscrow(t, ctx, k)
+
+	// nothing is left to liquidate at the former due height
+	nft.EndBlocker(ctx.WithBlockHeight(15), k)
+	checkOwner(t, ctx, k, nft.FighterDenom, "a", alice)
+}
+
+func TestLoanCancelledBeforeFunding(t *testing.T) {
+	ctx, k, _ := createTestInputWithBank(t)
+	nft.InitGenesis(ctx, k, nft.DefaultGenesisState())
+
+	loan := requestLoan(t, ctx, k, "a")
+	if err := k.CancelLoan(ctx, loan); err != nil {
+		t.Fatal(err)
+	}
+	checkOwner(t, ctx, k, nft.FighterDenom, "a", alice)
+	if _, found := k.GetLoan(ctx, loan.ID); found {
+		t.Fatal("expected the cancelled loan to be closed")
+	}
+	checkEscrow(t, ctx, k)
+}
+
+func TestLoanLiquidatedAtDueHeight(t *testing.T) {
+	ctx, k, bankKeeper := createTestInputWithBank(t)
+	nft.InitGenesis(ctx, k, nft.DefaultGenesisState())
+	bankKeeper.SetCoins(ctx, bob, principal)
+
+	loan, err := k.FundLoan(ctx, requestLoan(t, ctx, k, "a"), bob)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	nft.EndBlocker(ctx.WithBlockHeight(loan.DueHeight-1), k)
+	checkOwner(t, ctx, k, nft.FighterDenom, "a", nft.EscrowAddress)
+
+	nft.EndBlocker(ctx.WithBlockHeight(loan.DueHeight), k)
+	checkOwner(t, ctx, k, nft.FighterDenom, "a", bob)
+	if _, found := k.GetLoan(ctx, loan.ID); found {
+		t.Fatal("expected the liquidated loan to be closed")
+	}
+	checkEscrow(t, ctx, k)
+
+	// the defaulted borrower can't repay anymore
+	if err := k.RepayLoan(ctx, loan); err == nil {
+		t.Fatal("expected the repayment of a liquidated loan to fail")
+	}
+	checkOwner(t, ctx, k, nft.FighterDenom, "a", bob)
+}
+
+func TestLoanLiquidationWithoutCollateral(t *testing.T) {
+	ctx, k, bankKeeper := createTestInputWithBank(t)
+	nft.InitGenesis(ctx, k, nft.DefaultGenesisState())
+	bankKeeper.SetCoins(ctx, bob, principal)
+
+	loan, err := k.FundLoan(ctx, requestLoan(t, ctx, k, "a"), bob)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := k.DeleteNFT(ctx, nft.FighterDenom, "a"); err != nil {
+		t.Fatal(err)
+	}
+
+	// the loan is dropped instead of halting the chain
+	nft.EndBlocker(ctx.WithBlockHeight(loan.DueHeight), k)
+	if _, found := k.GetLoan(ctx, loan.ID); found {
+		t.Fatal("expected the loan without collateral to be removed")
+	}
+}
+
+func TestLoanDuration(t *testing.T) {
+	for _, tc := range []struct {
+		duration int64
+		valid    bool
+	}{
+		{0, false},
+		{1, true},
+		{nft.MaxLoanDuration, true},
+		{nft.MaxLoanDuration + 1, false},
+		{1<<63 - 1, false},
+	} {
+		msg := nft.NewMsgRequestLoan(alice, nft.FighterDenom, "a", principal, interest, tc.duration)
+		if err := msg.ValidateBasic(); (err == nil) != tc.valid {
+			t.Errorf("expected a duration of %d to be valid: %t, got %v", tc.duration, tc.valid, err)
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package collectables_test
+
+import (
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	nft "github.com/tosch110/collectables/x/collectables"
+)
+
+func TestRentalLifecycle(t *testing.T) {
+	ctx, k, _ := createTestInput(t)
+	nft.InitGenesis(ctx, k, nft.DefaultGenesisState())
+	handler := nft.GenericHandler(k)
+	mint(t, ctx, k, nft.FighterDenom, "a", alice, nil)
+
+	token, _ := k.GetNFT(ctx, nft.FighterDenom, "a")
+	if err := k.RentNFT(ctx, nft.FighterDenom, token, bob, 20); err != nil {
+		t.Fatal(err)
+	}
+
+	// the NFT can't be rented again, even by its current user, before the rental ends
+	token, _ = k.GetNFT(ctx, nft.FighterDenom, "a")
+	for _, user := range []sdk.AccAddress{bob, carol} {
+		if err := k.RentNFT(ctx.WithBlockHeight(19), nft.FighterDenom, token, user, 30); !errors.Is(err, nft.ErrRented) {
+			t.Fatalf("expected the rental to %s to return %v, got %v", user, nft.ErrRented, err)
+		}
+	}
+
+	// nor change hands while it is rented
+	if _, err := handler(ctx, nft.NewMsgSendNFT(alice, carol, nft.FighterDenom, "a")); !errors.Is(err, nft.ErrRented) {
+		t.Fatalf("expected %v, got %v", nft.ErrRented, err)
+	}
+	checkOwner(t, ctx, k, nft.FighterDenom, "a", alice)
+
+	// the user keeps the NFT through the block before the expiry
+	nft.EndBlocker(ctx.WithBlockHeight(19), k)
+	if token, _ = k.GetNFT(ctx, nft.FighterDenom, "a"); !token.GetUser().Equals(bob) {
+		t.Fatalf("expected bob to use the NFT until block 20, got %s", token.GetUser())
+	}
+
+	// and loses it at the end of block expires
+	ctx = ctx.WithBlockHeight(20)
+	nft.EndBlocker(ctx, k)
+	if token, _ = k.GetNFT(ctx, nft.FighterDenom, "a"); nft.IsRented(token) {
+		t.Fatalf("expected the rental to end at block 20, the NFT is used by %s", token.GetUser())
+	}
+
+	// once it ended, the NFT can be rented again and transferred
+	if err := k.RentNFT(ctx, nft.FighterDenom, token, carol, 30); err != nil {
+		t.Fatal(err)
+	}
+	nft.EndBlocker(ctx.WithBlockHeight(30), k)
+	if _, err := handler(ctx, nft.NewMsgSendNFT(alice, carol, nft.FighterDenom, "a")); err != nil {
+		t.Fatal(err)
+	}
+	checkOwner(t, ctx, k, nft.FighterDenom, "a", carol)
+}
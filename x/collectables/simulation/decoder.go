@@ -0,0 +1,66 @@
+package simulation
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	tmkv "github.com/tendermint/tendermint/libs/kv"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/tosch110/collectables/x/collectables/types"
+)
+
+// DecodeStore unmarshals the KVPair's Value to the corresponding collectables type
+func DecodeStore(cdc *codec.Codec, kvA, kvB tmkv.Pair) string {
+	switch {
+	case bytes.Equal(kvA.Key[:1], types.CollectionsKeyPrefix):
+		collectionA, collectionB := types.MustUnmarshalCollection(kvA.Value), types.MustUnmarshalCollection(kvB.Value)
+		return fmt.Sprintf("%v\n%v", collectionA, collectionB)
+
+	case bytes.Equal(kvA.Key[:1], types.OwnersKeyPrefix):
+		idCollectionA, idCollectionB := types.MustUnmarshalIDCollection(kvA.Value), types.MustUnmarshalIDCollection(kvB.Value)
+		return fmt.Sprintf("%v\n%v", idCollectionA, idCollectionB)
+
+	case bytes.Equal(kvA.Key[:1], types.HashesKeyPrefix),
+		bytes.Equal(kvA.Key[:1], types.RentalsKeyPrefix),
+		bytes.Equal(kvA.Key[:1], types.EquipmentKeyPrefix),
+		bytes.Equal(kvA.Key[:1], types.CreatorsKeyPrefix),
+		bytes.Equal(kvA.Key[:1], types.HoldersKeyPrefix),
+		bytes.Equal(kvA.Key[:1], types.TraitsKeyPrefix):
+		refA, refB := types.MustUnmarshalNFTRef(kvA.Value), types.MustUnmarshalNFTRef(kvB.Value)
+		return fmt.Sprintf("%v\n%v", refA, refB)
+
+	case bytes.Equal(kvA.Key[:1], types.TraitCountsKeyPrefix),
+		bytes.Equal(kvA.Key[:1], types.LoanDuesKeyPrefix),
+		bytes.Equal(kvA.Key[:1], types.NextLoanIDKey),
+		bytes.Equal(kvA.Key[:1], types.NextBundleIDKey),
+		bytes.Equal(kvA.Key[:1], types.NextSwapIDKey),
+		bytes.Equal(kvA.Key[:1], types.StoreVersionKey):
+		return fmt.Sprintf("%d\n%d", binary.BigEndian.Uint64(kvA.Value), binary.BigEndian.Uint64(kvB.Value))
+
+	case bytes.Equal(kvA.Key[:1], types.LoansKeyPrefix):
+		loanA, loanB := types.MustUnmarshalLoan(kvA.Value), types.MustUnmarshalLoan(kvB.Value)
+		return fmt.Sprintf("%v\n%v", loanA, loanB)
+
+	case bytes.Equal(kvA.Key[:1], types.VaultsKeyPrefix):
+		vaultA, vaultB := types.MustUnmarshalVault(kvA.Value), types.MustUnmarshalVault(kvB.Value)
+		return fmt.Sprintf("%v\n%v", vaultA, vaultB)
+
+	case bytes.Equal(kvA.Key[:1], types.BundlesKeyPrefix):
+		bundleA, bundleB := types.MustUnmarshalBundle(kvA.Value), types.MustUnmarshalBundle(kvB.Value)
+		return fmt.Sprintf("%v\n%v", bundleA, bundleB)
+
+	case bytes.Equal(kvA.Key[:1], types.SwapsKeyPrefix):
+		swapA, swapB := types.MustUnmarshalSwap(kvA.Value), types.MustUnmarshalSwap(kvB.Value)
+		return fmt.Sprintf("%v\n%v", swapA, swapB)
+
+	case bytes.Equal(kvA.Key[:1], types.HistoryKeyPrefix):
+		entryA, entryB := types.MustUnmarshalHistoryEntry(kvA.Value), types.MustUnmarshalHistoryEntry(kvB.Value)
+		return fmt.Sprintf("%v\n%v", entryA, entryB)
+
+	default:
+		panic(fmt.Sprintf("invalid %s key prefix %X", types.ModuleName, kvA.Key[:1]))
+	}
+}
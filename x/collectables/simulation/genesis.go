@@ -0,0 +1,88 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/tosch110/collectables/x/collectables/keeper"
+	"github.com/tosch110/collectables/x/collectables/types"
+)
+
+// Denoms of the collections created by the randomized genesis
+var genesisDenoms = []string{types.FighterDenom, types.ItemDenom, "cards"}
+
+// maxGenesisSupply is the maximum number of NFTs of a collection at genesis, collections are
+// stored as a single value so large ones make every transaction touching them expensive
+const maxGenesisSupply = 50
+
+// maxGenesisHistoryLimit is the maximum number of history entries kept per NFT at genesis
+const maxGenesisHistoryLimit = 20
+
+// RandomizedGenState generates a random GenesisState for the collectables module, with
+// collections of NFTs held by the simulation accounts
+func RandomizedGenState(simState *module.SimulationState) {
+	ids := make(map[string]map[string][]string) // owner address -> denom -> IDs
+	collections := types.NewCollections()
+	for _, denom := range genesisDenoms {
+		collection := types.NewCollection(denom, types.NewNFTs())
+		collection.GeneratorVersion = keeper.TraitGeneratorV1{}.Version()
+
+		supply := simState.Rand.Intn(maxGenesisSupply + 1)
+		for i := 0; i < supply; i++ {
+			owner, _ := simulation.RandomAcc(simState.Rand, simState.Accounts)
+			proof := RandomProof(simState.Rand)
+			nft := types.NewBaseNFT(fmt.Sprintf("%d", i+1), owner.Address, ProofHash(proof), proof,
+				simulation.RandStringOfLength(simState.Rand, 10), 0, 0, nil)
+			nft.SetCreator(owner.Address)
+			collection.NFTs = collection.NFTs.Append(&nft)
+
+			address := owner.Address.String()
+			if ids[address] == nil {
+				ids[address] = make(map[string][]string)
+			}
+			ids[address][denom] = append(ids[address][denom], nft.GetID())
+		}
+		collections = collections.Append(collection)
+	}
+
+	// owners follow the order of the accounts to keep the genesis deterministic
+	var owners []types.Owner
+	for _, acc := range simState.Accounts {
+		byDenom, ok := ids[acc.Address.String()]
+		if !ok {
+			continue
+		}
+		owner := types.NewOwner(acc.Address)
+		for _, denom := range genesisDenoms {
+			if len(byDenom[denom]) > 0 {
+				owner.IDCollections = append(owner.IDCollections, types.NewIDCollection(denom, byDenom[denom]))
+			}
+		}
+		owners = append(owners, owner)
+		delete(ids, acc.Address.String())
+	}
+
+	// a small history limit gets the history of the most active NFTs pruned during the simulation
+	params := types.NewParams(uint64(simState.Rand.Intn(maxGenesisHistoryLimit + 1)))
+
+	nftGenesis := types.NewGenesisState(owners, collections, types.Loans{}, 1,
+		types.Vaults{}, types.Bundles{}, 1, types.Swaps{}, 1, params, types.History{}, types.DeriveHolders(collections))
+
+	fmt.Printf("Selected randomly generated %s genesis with %d owners\n", types.ModuleName, len(owners))
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(nftGenesis)
+}
+
+// RandomProof returns a random proof of a valid length
+func RandomProof(r *rand.Rand) string {
+	return simulation.RandStringOfLength(r, simulation.RandIntBetween(r, types.MinProofLength, 64))
+}
+
+// ProofHash returns the hex encoded blake3 hash a proof has to match when minting
+func ProofHash(proof string) string {
+	return types.HashProof(proof)
+}
@@ -0,0 +1,292 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/tosch110/collectables/x/collectables/keeper"
+	"github.com/tosch110/collectables/x/collectables/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgSendNFT         = "op_weight_msg_send_nft"
+	OpWeightMsgEditNFTMetadata = "op_weight_msg_edit_nft_metadata"
+	OpWeightMsgEditNFTPrice    = "op_weight_msg_edit_nft_price"
+	OpWeightMsgMintNFT         = "op_weight_msg_mint_nft"
+	OpWeightMsgBurnNFT         = "op_weight_msg_burn_nft"
+	OpWeightMsgBuyNFT          = "op_weight_msg_buy_nft"
+	OpWeightMsgChallengeNFT    = "op_weight_msg_challenge_nft"
+)
+
+// simulationGas is the gas of the simulated transactions, a transaction reads and writes the whole
+// collection of the NFTs it touches which takes more than the default gas once collections grow
+const simulationGas = 10 * helpers.DefaultGenTxGas
+
+// Default simulation operation weights
+const (
+	DefaultWeightMsgSendNFT         = 100
+	DefaultWeightMsgEditNFTMetadata = 50
+	DefaultWeightMsgEditNFTPrice    = 80
+	DefaultWeightMsgMintNFT         = 100
+	DefaultWeightMsgBurnNFT         = 20
+	DefaultWeightMsgBuyNFT          = 80
+	DefaultWeightMsgChallengeNFT    = 80
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(appParams simulation.AppParams, cdc *codec.Codec, ak types.AccountKeeper,
+	k keeper.Keeper) simulation.WeightedOperations {
+
+	weight := func(key string, defaultWeight int) (w int) {
+		appParams.GetOrGenerate(cdc, key, &w, nil,
+			func(_ *rand.Rand) {
+				w = defaultWeight
+			},
+		)
+		return w
+	}
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgSendNFT, DefaultWeightMsgSendNFT),
+			SimulateMsgSendNFT(ak, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgEditNFTMetadata, DefaultWeightMsgEditNFTMetadata),
+			SimulateMsgEditNFTMetadata(ak, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgEditNFTPrice, DefaultWeightMsgEditNFTPrice),
+			SimulateMsgEditNFTPrice(ak, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgMintNFT, DefaultWeightMsgMintNFT),
+			SimulateMsgMintNFT(ak, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgBurnNFT, DefaultWeightMsgBurnNFT),
+			SimulateMsgBurnNFT(ak, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgBuyNFT, DefaultWeightMsgBuyNFT),
+			SimulateMsgBuyNFT(ak, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgChallengeNFT, DefaultWeightMsgChallengeNFT),
+			SimulateMsgChallengeNFT(ak, k),
+		),
+	}
+}
+
+// SimulateMsgSendNFT simulates the transfer of an NFT between two simulation accounts
+func SimulateMsgSendNFT(ak types.AccountKeeper, k keeper.Keeper) simulation.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account, chainID string,
+	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+		denom, nft, owner, found := randomOwnedNFT(r, ctx, k, accs, func(_ string, nft types.NFT) bool {
+			return types.CheckTransferable(nft) == nil
+		})
+		if !found {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+		recipient, _ := simulation.RandomAcc(r, accs)
+
+		msg := types.NewMsgSendNFT(owner.Address, recipient.Address, denom, nft.GetID())
+		return deliver(r, app, ctx, ak, chainID, msg, nil, owner)
+	}
+}
+
+// SimulateMsgEditNFTMetadata simulates the owner of an NFT renaming it
+func SimulateMsgEditNFTMetadata(ak types.AccountKeeper, k keeper.Keeper) simulation.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account, chainID string,
+	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+		denom, nft, owner, found := randomOwnedNFT(r, ctx, k, accs, func(denom string, nft types.NFT) bool {
+			collection, found := k.GetCollection(ctx, denom)
+			return found && collection.IsRevealed() && !collection.Frozen && !nft.IsFrozen()
+		})
+		if !found {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		msg := types.NewMsgEditNFTMetadata(owner.Address, nft.GetID(), denom,
+			simulation.RandStringOfLength(r, 10), simulation.RandStringOfLength(r, 45),
+			simulation.RandStringOfLength(r, 45), nil)
+		return deliver(r, app, ctx, ak, chainID, msg, nil, owner)
+	}
+}
+
+// SimulateMsgEditNFTPrice simulates the owner of an NFT listing it for sale
+func SimulateMsgEditNFTPrice(ak types.AccountKeeper, k keeper.Keeper) simulation.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account, chainID string,
+	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+		denom, nft, owner, found := randomOwnedNFT(r, ctx, k, accs, func(_ string, nft types.NFT) bool {
+			return types.CheckTransferable(nft) == nil
+		})
+		if !found {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		msg := types.NewMsgEditNFTPrice(owner.Address, nft.GetID(), denom, randomPrice(r))
+		return deliver(r, app, ctx, ak, chainID, msg, nil, owner)
+	}
+}
+
+// SimulateMsgMintNFT simulates the mint of an NFT for a simulation account
+func SimulateMsgMintNFT(ak types.AccountKeeper, k keeper.Keeper) simulation.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account, chainID string,
+	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+		sender, _ := simulation.RandomAcc(r, accs)
+		recipient, _ := simulation.RandomAcc(r, accs)
+
+		denom := genesisDenoms[r.Intn(len(genesisDenoms))]
+		id := simulation.RandStringOfLength(r, 10)
+		proof := RandomProof(r)
+		hash := ProofHash(proof)
+		if k.IsNFT(ctx, denom, id) || k.HasHash(ctx, denom, hash) {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		msg := types.NewMsgMintNFT(sender.Address, recipient.Address, id, denom, hash, proof,
+			simulation.RandStringOfLength(r, 10), sdk.NewCoins(), types.TransferPolicyTransferable)
+		return deliver(r, app, ctx, ak, chainID, msg, nil, sender)
+	}
+}
+
+// SimulateMsgBurnNFT simulates the owner of an NFT burning it
+func SimulateMsgBurnNFT(ak types.AccountKeeper, k keeper.Keeper) simulation.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account, chainID string,
+	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+		denom, nft, owner, found := randomOwnedNFT(r, ctx, k, accs, func(denom string, nft types.NFT) bool {
+			return denom != types.BundleDenom && types.CheckBurnable(nft) == nil &&
+				!k.HasEquipment(ctx, types.NewNFTRef(denom, nft.GetID()))
+		})
+		if !found {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		msg := types.NewMsgBurnNFT(owner.Address, nft.GetID(), denom)
+		return deliver(r, app, ctx, ak, chainID, msg, nil, owner)
+	}
+}
+
+// SimulateMsgBuyNFT simulates a funded simulation account buying an NFT listed for sale
+func SimulateMsgBuyNFT(ak types.AccountKeeper, k keeper.Keeper) simulation.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account, chainID string,
+	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+		buyer, _ := simulation.RandomAcc(r, accs)
+		spendable := ak.GetAccount(ctx, buyer.Address).SpendableCoins(ctx.BlockTime())
+
+		denom, nft, _, found := randomOwnedNFT(r, ctx, k, accs, func(_ string, nft types.NFT) bool {
+			return !nft.GetPrice().IsZero() && !nft.GetOwner().Equals(buyer.Address) &&
+				spendable.IsAllGTE(nft.GetPrice()) && types.CheckTransferable(nft) == nil
+		})
+		if !found {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		msg := types.NewMsgBuyNFT(buyer.Address, nft.GetID(), denom, nft.GetPrice())
+		return deliver(r, app, ctx, ak, chainID, msg, nft.GetPrice(), buyer)
+	}
+}
+
+// SimulateMsgChallengeNFT simulates the owner of an NFT challenging another NFT
+func SimulateMsgChallengeNFT(ak types.AccountKeeper, k keeper.Keeper) simulation.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account, chainID string,
+	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+		contenderDenom, contender, owner, found := randomOwnedNFT(r, ctx, k, accs, func(_ string, nft types.NFT) bool {
+			return types.CanUse(nft, nft.GetOwner()) && types.CheckUsable(nft) == nil
+		})
+		if !found {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+		defiantDenom, defiant, _, found := randomOwnedNFT(r, ctx, k, accs, func(denom string, nft types.NFT) bool {
+			return (denom != contenderDenom || nft.GetID() != contender.GetID()) && types.CheckTransferable(nft) == nil
+		})
+		if !found {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		msg := types.NewMsgChallengeNFT(owner.Address, contender.GetID(), contenderDenom, defiant.GetID(), defiantDenom, "")
+		return deliver(r, app, ctx, ak, chainID, msg, nil, owner)
+	}
+}
+
+// randomOwnedNFT returns a random NFT held by a simulation account and accepted by the filter
+func randomOwnedNFT(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, accs []simulation.Account,
+	filter func(denom string, nft types.NFT) bool,
+) (denom string, nft types.NFT, owner simulation.Account, found bool) {
+	var candidates []types.NFTRef
+	k.IterateCollections(ctx, func(collection types.Collection) bool {
+		for _, nft := range collection.NFTs {
+			if _, ok := simulation.FindAccount(accs, nft.GetOwner()); ok && filter(collection.Denom, nft) {
+				candidates = append(candidates, types.NewNFTRef(collection.Denom, nft.GetID()))
+			}
+		}
+		return false
+	})
+	if len(candidates) == 0 {
+		return "", nil, simulation.Account{}, false
+	}
+
+	ref := candidates[r.Intn(len(candidates))]
+	nft, err := k.GetNFT(ctx, ref.Denom, ref.ID)
+	if err != nil {
+		panic(err)
+	}
+	owner, _ = simulation.FindAccount(accs, nft.GetOwner())
+	return ref.Denom, nft, owner, true
+}
+
+// randomPrice returns a random price in the bond denom
+func randomPrice(r *rand.Rand) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(simulation.RandIntBetween(r, 1, 1000))))
+}
+
+// deliver signs a message with random fees the signer can pay on top of the coins the message spends and delivers it
+func deliver(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, ak types.AccountKeeper, chainID string,
+	msg sdk.Msg, spent sdk.Coins, signer simulation.Account,
+) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+	account := ak.GetAccount(ctx, signer.Address)
+	coins := account.SpendableCoins(ctx.BlockTime())
+
+	var (
+		fees sdk.Coins
+		err  error
+	)
+	coins, hasNeg := coins.SafeSub(spent)
+	if !hasNeg {
+		fees, err = simulation.RandomFees(r, ctx, coins)
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+	}
+
+	tx := helpers.GenTx(
+		[]sdk.Msg{msg},
+		fees,
+		simulationGas,
+		chainID,
+		[]uint64{account.GetAccountNumber()},
+		[]uint64{account.GetSequence()},
+		signer.PrivKey,
+	)
+
+	_, _, err = app.Deliver(tx)
+	if err != nil {
+		return simulation.NoOpMsg(types.ModuleName), nil, err
+	}
+
+	return simulation.NewOperationMsg(msg, true, ""), nil, nil
+}
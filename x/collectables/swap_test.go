@@ -0,0 +1,110 @@
+package collectables_test
+
+import (
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+
+	nft "github.com/tosch110/collectables/x/collectables"
+)
+
+var (
+	offeredCoins   = sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+	requestedCoins = sdk.NewCoins(sdk.NewInt64Coin("stake", 5))
+)
+
+// proposeSwap has alice offer her fighter a and 10stake to bob for his fighter b and 5stake
+func proposeSwap(t *testing.T, ctx sdk.Context, k nft.Keeper, bankKeeper bank.Keeper) nft.Swap {
+	mint(t, ctx, k, nft.FighterDenom, "a", alice, nil)
+	mint(t, ctx, k, nft.FighterDenom, "b", bob, nil)
+	if err := bankKeeper.SetCoins(ctx, alice, offeredCoins); err != nil {
+		t.Fatal(err)
+	}
+	if err := bankKeeper.SetCoins(ctx, bob, requestedCoins); err != nil {
+		t.Fatal(err)
+	}
+
+	swap, err := k.ProposeSwap(ctx, alice, bob,
+		[]nft.NFTRef{nft.NewNFTRef(nft.FighterDenom, "a")}, offeredCoins,
+		[]nft.NFTRef{nft.NewNFTRef(nft.FighterDenom, "b")}, requestedCoins)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkOwner(t, ctx, k, nft.FighterDenom, "a", nft.EscrowAddress)
+	checkEscrow(t, ctx, k)
+	return swap
+}
+
+func TestSwapAccept(t *testing.T) {
+	ctx, k, bankKeeper := createTestInputWithBank(t)
+	nft.InitGenesis(ctx, k, nft.DefaultGenesisState())
+	swap := proposeSwap(t, ctx, k, bankKeeper)
+
+	if err := k.AcceptSwap(ctx, swap); err != nil {
+		t.Fatal(err)
+	}
+	checkOwner(t, ctx, k, nft.FighterDenom, "a", bob)
+	checkOwner(t, ctx, k, nft.FighterDenom, "b", alice)
+	if !bankKeeper.GetCoins(ctx, alice).IsEqual(requestedCoins) || !bankKeeper.GetCoins(ctx, bob).IsEqual(offeredCoins) {
+		t.Fatalf("expected the coins to be swapped, alice holds %s and bob %s", bankKeeper.GetCoins(ctx, alice), bankKeeper.GetCoins(ctx, bob))
+	}
+	if _, found := k.GetSwap(ctx, swap.ID); found {
+		t.Fatal("expected the accepted swap to be closed")
+	}
+	checkEscrow(t, ctx, k)
+}
+
+func TestSwapCancel(t *testing.T) {
+	ctx, k, bankKeeper := createTestInputWithBank(t)
+	nft.InitGenesis(ctx, k, nft.DefaultGenesisState())
+	swap := proposeSwap(t, ctx, k, bankKeeper)
+
+	if err := k.CancelSwap(ctx, swap); err != nil {
+		t.Fatal(err)
+	}
+	checkOwner(t, ctx, k, nft.FighterDenom, "a", alice)
+	checkOwner(t, ctx, k, nft.FighterDenom, "b", bob)
+	if !bankKeeper.GetCoins(ctx, alice).IsEqual(offeredCoins) || !bankKeeper.GetCoins(ctx, bob).IsEqual(requestedCoins) {
+		t.Fatalf("expected the coins to be given back, alice holds %s and bob %s", bankKeeper.GetCoins(ctx, alice), bankKeeper.GetCoins(ctx, bob))
+	}
+	if _, found := k.GetSwap(ctx, swap.ID); found {
+		t.Fatal("expected the cancelled swap to be closed")
+	}
+	checkEscrow(t, ctx, k)
+}
+
+func TestSwapAcceptFailure(t *testing.T) {
+	ctx, k, bankKeeper := createTestInputWithBank(t)
+	nft.InitGenesis(ctx, k, nft.DefaultGenesisState())
+	swap := proposeSwap(t, ctx, k, bankKeeper)
+
+	// the counterparty can't pay the requested coins
+	if err := bankKeeper.SetCoins(ctx, bob, sdk.NewCoins()); err != nil {
+		t.Fatal(err)
+	}
+	if err := k.AcceptSwap(ctx, swap); err == nil {
+		t.Fatal("expected the acceptance without the requested coins to fail")
+	}
+	checkOwner(t, ctx, k, nft.FighterDenom, "b", bob)
+	checkEscrow(t, ctx, k)
+	if err := bankKeeper.SetCoins(ctx, bob, requestedCoins); err != nil {
+		t.Fatal(err)
+	}
+
+	// the escrowed NFT is gone, so the swap fails after the counterparty already paid and gave its NFT
+	if err := k.DeleteNFT(ctx, nft.FighterDenom, "a"); err != nil {
+		t.Fatal(err)
+	}
+	if err := k.AcceptSwap(ctx, swap); !errors.Is(err, nft.ErrUnknownNFT) {
+		t.Fatalf("expected %v, got %v", nft.ErrUnknownNFT, err)
+	}
+	checkOwner(t, ctx, k, nft.FighterDenom, "b", bob)
+	if !bankKeeper.GetCoins(ctx, alice).IsZero() || !bankKeeper.GetCoins(ctx, bob).IsEqual(requestedCoins) {
+		t.Fatalf("expected no coins to move, alice holds %s and bob %s", bankKeeper.GetCoins(ctx, alice), bankKeeper.GetCoins(ctx, bob))
+	}
+	if _, found := k.GetSwap(ctx, swap.ID); !found {
+		t.Fatal("expected the swap to stay open")
+	}
+}
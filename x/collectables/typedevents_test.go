@@ -0,0 +1,112 @@
+package collectables_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	nft "github.com/tosch110/collectables/x/collectables"
+	"github.com/tosch110/collectables/x/collectables/types"
+)
+
+// typedEvent is implemented by all the typed events
+type typedEvent interface {
+	ToEvent() sdk.Event
+}
+
+// stringify returns an emitted event as read back from an ABCI response
+func stringify(event sdk.Event) sdk.StringEvent {
+	return sdk.StringifyEvent(abci.Event(event))
+}
+
+func TestTypedEventsRoundTrip(t *testing.T) {
+	price := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+	for _, tc := range []struct {
+		event typedEvent
+		parse func(sdk.StringEvent) (typedEvent, error)
+	}{
+		{
+			nft.EventMintNFT{Denom: nft.FighterDenom, ID: "a", Owner: alice, Hash: nft.HashProof("proof"), Proof: "proof",
+				Name: "A", Price: price, TransferPolicy: nft.TransferPolicySoulbound},
+			func(e sdk.StringEvent) (typedEvent, error) { return nft.ParseEventMintNFT(e) },
+		},
+		{
+			nft.EventTransferNFT{Denom: nft.FighterDenom, ID: "a", PreviousOwner: alice, NewOwner: bob},
+			func(e sdk.StringEvent) (typedEvent, error) { return nft.ParseEventTransferNFT(e) },
+		},
+		{
+			nft.EventBuyNFT{Denom: nft.FighterDenom, ID: "a", PreviousOwner: alice, NewOwner: bob, Price: price},
+			func(e sdk.StringEvent) (typedEvent, error) { return nft.ParseEventBuyNFT(e) },
+		},
+		{
+			nft.EventBurnNFT{Denom: nft.FighterDenom, ID: "a", PreviousOwner: alice},
+			func(e sdk.StringEvent) (typedEvent, error) { return nft.ParseEventBurnNFT(e) },
+		},
+		{
+			nft.EventChallengeNFT{Challenger: alice, Contender: nft.NewNFTRef(nft.FighterDenom, "a"),
+				Defiant: nft.NewNFTRef(nft.FighterDenom, "b"), Winner: nft.WinnerContestant, PreviousOwner: bob, NewOwner: alice},
+			func(e sdk.StringEvent) (typedEvent, error) { return nft.ParseEventChallengeNFT(e) },
+		},
+		{
+			// an empty price takes the NFT off sale
+			nft.EventEditNFTPrice{Denom: nft.FighterDenom, ID: "a", Owner: alice, PreviousPrice: price},
+			func(e sdk.StringEvent) (typedEvent, error) { return nft.ParseEventEditNFTPrice(e) },
+		},
+	} {
+		emitted := stringify(tc.event.ToEvent())
+		parsed, err := tc.parse(emitted)
+		if err != nil {
+			t.Fatalf("%s: %v", emitted.Type, err)
+		}
+		if !reflect.DeepEqual(parsed, tc.event) {
+			t.Errorf("%s: expected %+v, got %+v", emitted.Type, tc.event, parsed)
+		}
+
+		// another type of event is refused
+		if _, err := tc.parse(stringify(nft.EventBurnNFT{}.ToEvent())); emitted.Type != nft.EventTypeBurnNFT && !errors.Is(err, nft.ErrInvalidEvent) {
+			t.Errorf("%s: expected %v for a burn event, got %v", emitted.Type, nft.ErrInvalidEvent, err)
+		}
+
+		// so is an event missing or repeating any of its attributes
+		for i, attr := range emitted.Attributes {
+			missing := sdk.StringEvent{Type: emitted.Type}
+			missing.Attributes = append(missing.Attributes, emitted.Attributes[:i]...)
+			missing.Attributes = append(missing.Attributes, emitted.Attributes[i+1:]...)
+			if _, err := tc.parse(missing); !errors.Is(err, nft.ErrInvalidEvent) {
+				t.Errorf("%s: expected %v without %s, got %v", emitted.Type, nft.ErrInvalidEvent, attr.Key, err)
+			}
+
+			repeated := sdk.StringEvent{Type: emitted.Type, Attributes: append(emitted.Attributes[:len(emitted.Attributes):len(emitted.Attributes)], attr)}
+			if _, err := tc.parse(repeated); !errors.Is(err, nft.ErrInvalidEvent) {
+				t.Errorf("%s: expected %v with two %s, got %v", emitted.Type, nft.ErrInvalidEvent, attr.Key, err)
+			}
+		}
+	}
+}
+
+func TestTypedEventsMalformedAttributes(t *testing.T) {
+	mint := stringify(nft.EventMintNFT{Denom: nft.FighterDenom, ID: "a", Owner: alice, Name: "A"}.ToEvent())
+	for _, tc := range []struct {
+		key, value string
+	}{
+		{nft.AttributeKeyOwner, "not-a-bech32-address"},
+		{nft.AttributeKeyOwner, sdk.ValAddress(alice).String()},
+		{nft.AttributeKeyNFTPrice, "ten stake"},
+		{types.AttributeKeyTransferPolicy, "unknown-policy"},
+	} {
+		malformed := sdk.StringEvent{Type: mint.Type}
+		for _, attr := range mint.Attributes {
+			if attr.Key == tc.key {
+				attr.Value = tc.value
+			}
+			malformed.Attributes = append(malformed.Attributes, attr)
+		}
+		if _, err := nft.ParseEventMintNFT(malformed); !errors.Is(err, nft.ErrInvalidEvent) {
+			t.Errorf("expected %v for %s %q, got %v", nft.ErrInvalidEvent, tc.key, tc.value, err)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// BundleDenom is the collection reserved for the NFTs wrapping bundles
+	BundleDenom = "bundles"
+
+	// MaxBundleSize is the maximum number of NFTs wrapped in a single bundle
+	MaxBundleSize = 50
+)
+
+// BundleProof returns the proof of the NFT of a bundle, its hash is the blake3 digest of the proof
+func BundleProof(id string) string {
+	return fmt.Sprintf("%s bundle #%s", ModuleName, id)
+}
+
+// Bundle is a set of NFTs held in escrow and owned as a whole through the bundle NFT
+type Bundle struct {
+	ID         string   `json:"id" yaml:"id"`                 // ID of the bundle NFT in the bundle collection
+	Components []NFTRef `json:"components" yaml:"components"` // NFTs wrapped in the bundle
+}
+
+// NewBundle creates a new bundle
+func NewBundle(id string, components []NFTRef) Bundle {
+	return Bundle{
+		ID:         strings.TrimSpace(id),
+		Components: components,
+	}
+}
+
+// Ref returns the reference of the bundle NFT
+func (bundle Bundle) Ref() NFTRef {
+	return NewNFTRef(BundleDenom, bundle.ID)
+}
+
+// Validate checks that a bundle wraps between 2 and MaxBundleSize distinct NFTs other than itself
+func (bundle Bundle) Validate() error {
+	if strings.TrimSpace(bundle.ID) == "" {
+		return sdkerrors.Wrap(ErrInvalidBundle, "bundle needs an ID")
+	}
+	return ValidateBundleComponents(bundle.Ref(), bundle.Components)
+}
+
+// ValidateBundleComponents checks that the components of a bundle are between 2 and MaxBundleSize
+// distinct NFTs, none of which is the bundle itself
+func ValidateBundleComponents(self NFTRef, components []NFTRef) error {
+	if len(components) < 2 || len(components) > MaxBundleSize {
+		return sdkerrors.Wrap(ErrInvalidBundle, fmt.Sprintf("a bundle wraps between 2 and %d NFTs, got %d", MaxBundleSize, len(components)))
+	}
+	seen := make(map[NFTRef]bool, len(components))
+	for _, ref := range components {
+		if strings.TrimSpace(ref.Denom) == "" || strings.TrimSpace(ref.ID) == "" {
+			return sdkerrors.Wrap(ErrInvalidBundle, "bundle component needs a denom and an ID")
+		}
+		if ref == self {
+			return sdkerrors.Wrap(ErrInvalidBundle, fmt.Sprintf("bundle %s can't wrap itself", self))
+		}
+		if seen[ref] {
+			return sdkerrors.Wrap(ErrInvalidBundle, fmt.Sprintf("NFT %s is wrapped twice", ref))
+		}
+		seen[ref] = true
+	}
+	return nil
+}
+
+func (bundle Bundle) String() string {
+	return fmt.Sprintf(`ID:         %s
+Components: %s`,
+		bundle.ID,
+		NFTRefs(bundle.Components),
+	)
+}
+
+// Bundles is a list of bundles
+type Bundles []Bundle
+
+func (bundles Bundles) String() string {
+	if len(bundles) == 0 {
+		return ""
+	}
+
+	out := ""
+	for _, bundle := range bundles {
+		out += fmt.Sprintf("%v\n", bundle.String())
+	}
+	return out[:len(out)-1]
+}
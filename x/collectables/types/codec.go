@@ -18,6 +18,27 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgBurnNFT{}, "cosmos-sdk/MsgBurnNFT", nil)
 	cdc.RegisterConcrete(MsgChallengeNFT{}, "cosmos-sdk/MsgChallengeNFT", nil)
 	cdc.RegisterConcrete(MsgBuyNFT{}, "cosmos-sdk/MsgBuyNFT", nil)
+	cdc.RegisterConcrete(MsgCreateCollection{}, "cosmos-sdk/MsgCreateCollection", nil)
+	cdc.RegisterConcrete(MsgFreezeMetadata{}, "cosmos-sdk/MsgFreezeMetadata", nil)
+	cdc.RegisterConcrete(MsgRevealCollection{}, "cosmos-sdk/MsgRevealCollection", nil)
+	cdc.RegisterConcrete(MsgFreezeNFT{}, "cosmos-sdk/MsgFreezeNFT", nil)
+	cdc.RegisterConcrete(MsgUnfreezeNFT{}, "cosmos-sdk/MsgUnfreezeNFT", nil)
+	cdc.RegisterConcrete(MsgClawbackNFT{}, "cosmos-sdk/MsgClawbackNFT", nil)
+	cdc.RegisterConcrete(MsgRentNFT{}, "cosmos-sdk/MsgRentNFT", nil)
+	cdc.RegisterConcrete(MsgRequestLoan{}, "cosmos-sdk/MsgRequestLoan", nil)
+	cdc.RegisterConcrete(MsgCancelLoan{}, "cosmos-sdk/MsgCancelLoan", nil)
+	cdc.RegisterConcrete(MsgFundLoan{}, "cosmos-sdk/MsgFundLoan", nil)
+	cdc.RegisterConcrete(MsgRepayLoan{}, "cosmos-sdk/MsgRepayLoan", nil)
+	cdc.RegisterConcrete(MsgFractionalize{}, "cosmos-sdk/MsgFractionalize", nil)
+	cdc.RegisterConcrete(MsgRedeem{}, "cosmos-sdk/MsgRedeem", nil)
+	cdc.RegisterConcrete(MsgBuyout{}, "cosmos-sdk/MsgBuyout", nil)
+	cdc.RegisterConcrete(MsgWrapNFTs{}, "cosmos-sdk/MsgWrapNFTs", nil)
+	cdc.RegisterConcrete(MsgUnwrapBundle{}, "cosmos-sdk/MsgUnwrapBundle", nil)
+	cdc.RegisterConcrete(MsgProposeSwap{}, "cosmos-sdk/MsgProposeSwap", nil)
+	cdc.RegisterConcrete(MsgAcceptSwap{}, "cosmos-sdk/MsgAcceptSwap", nil)
+	cdc.RegisterConcrete(MsgCancelSwap{}, "cosmos-sdk/MsgCancelSwap", nil)
+	cdc.RegisterConcrete(MsgEquip{}, "cosmos-sdk/MsgEquip", nil)
+	cdc.RegisterConcrete(MsgUnequip{}, "cosmos-sdk/MsgUnequip", nil)
 }
 
 // ModuleCdc generic sealed codec to be used throughout this module
@@ -6,6 +6,7 @@ import (
 	"sort"
 	"strings"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
@@ -13,6 +14,20 @@ import (
 type Collection struct {
 	Denom string `json:"denom,omitempty" yaml:"denom"` // name of the collection; not exported to clients
 	NFTs  NFTs   `json:"nfts" yaml:"nfts"`             // NFTs that belong to a collection
+
+	Creator sdk.AccAddress `json:"creator,omitempty" yaml:"creator"` // account that created the collection; empty if created by a mint
+	Schema  TraitSchema    `json:"schema,omitempty" yaml:"schema"`   // optional traits allowed on the NFTs of the collection
+
+	GeneratorVersion uint `json:"generator_version" yaml:"generator_version"` // version of the trait generator deriving the stats of new NFTs
+	Frozen           bool `json:"frozen" yaml:"frozen"`                       // metadata of all the NFTs of the collection can't be edited anymore
+
+	Commitment string `json:"commitment,omitempty" yaml:"commitment"` // blake3 hash of the metadata published by a delayed reveal
+	Revealed   bool   `json:"revealed" yaml:"revealed"`               // whether the committed metadata has been revealed
+
+	TransferPolicy TransferPolicy `json:"transfer_policy,omitempty" yaml:"transfer_policy"` // minimum transfer restriction of the NFTs of the collection
+
+	Issuer             sdk.AccAddress     `json:"issuer,omitempty" yaml:"issuer"`                           // optional compliance issuer of a regulated collection
+	IssuerCapabilities IssuerCapabilities `json:"issuer_capabilities,omitempty" yaml:"issuer_capabilities"` // powers of the issuer, fixed at creation
 }
 
 // NewCollection creates a new NFT Collection
@@ -23,6 +38,55 @@ func NewCollection(denom string, nfts NFTs) Collection {
 	}
 }
 
+// NewCreatedCollection creates a new empty NFT Collection owned by its creator
+func NewCreatedCollection(denom string, creator sdk.AccAddress, schema TraitSchema) Collection {
+	collection := NewCollection(denom, NewNFTs())
+	collection.Creator = creator
+	collection.Schema = schema
+	return collection
+}
+
+// IsRevealed returns false while the NFTs of a delayed reveal collection still have their placeholder metadata
+func (collection Collection) IsRevealed() bool {
+	return collection.Commitment == "" || collection.Revealed
+}
+
+// CheckIssuer returns an error unless the address is the issuer of the collection and
+// the issuer was given the capability when the collection was created
+func (collection Collection) CheckIssuer(address sdk.AccAddress, capability IssuerCapability) error {
+	if collection.Issuer.Empty() || !collection.Issuer.Equals(address) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized,
+			fmt.Sprintf("%s is not the issuer of collection %s", address, collection.Denom),
+		)
+	}
+	if !collection.IssuerCapabilities.Has(capability) {
+		return sdkerrors.Wrap(ErrMissingCapability,
+			fmt.Sprintf("collection %s wasn't created with the %s capability", collection.Denom, capability),
+		)
+	}
+	return nil
+}
+
+// CheckMinter returns an error unless the address can mint into the collection: anyone can mint into
+// a collection without a creator, otherwise only its creator or its issuer can. The NFTs of a delayed
+// reveal collection are minted by its creator alone until the reveal, so nobody else holds a committed ID.
+func (collection Collection) CheckMinter(address sdk.AccAddress) error {
+	if collection.Creator.Empty() || collection.Creator.Equals(address) {
+		return nil
+	}
+	if !collection.IsRevealed() {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized,
+			fmt.Sprintf("only the creator of collection %s can mint before the reveal", collection.Denom),
+		)
+	}
+	if !collection.Issuer.Empty() && collection.Issuer.Equals(address) {
+		return nil
+	}
+	return sdkerrors.Wrap(sdkerrors.ErrUnauthorized,
+		fmt.Sprintf("%s is neither the creator nor the issuer of collection %s", address, collection.Denom),
+	)
+}
+
 // EmptyCollection returns an empty collection
 func EmptyCollection() Collection {
 	return NewCollection("", NewNFTs())
@@ -91,9 +155,27 @@ func (collection Collection) Supply() int {
 // String follows stringer interface
 func (collection Collection) String() string {
 	return fmt.Sprintf(`Denom: 				%s
+Creator:			%s
+Schema:				%v
+Generator:			v%d
+Frozen:				%t
+Commitment:			%s
+Revealed:			%t
+Transfer:			%s
+Issuer:				%s
+Capabilities:		%s
 NFTs:
 %s`,
 		collection.Denom,
+		collection.Creator,
+		collection.Schema,
+		collection.GeneratorVersion,
+		collection.Frozen,
+		collection.Commitment,
+		collection.IsRevealed(),
+		collection.TransferPolicy,
+		collection.Issuer,
+		collection.IssuerCapabilities,
 		collection.NFTs.String(),
 	)
 }
@@ -186,7 +268,8 @@ func (collections *Collections) UnmarshalJSON(b []byte) error {
 	}
 
 	for denom, collection := range collectionJSON {
-		*collections = append(*collections, NewCollection(denom, collection.NFTs))
+		collection.Denom = strings.TrimSpace(denom)
+		*collections = append(*collections, collection)
 	}
 
 	return nil
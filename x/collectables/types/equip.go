@@ -0,0 +1,65 @@
+package types
+
+import (
+	"fmt"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// ItemDenom is the collection of the NFTs that can be equipped
+	ItemDenom = "items"
+	// FighterDenom is the collection of the NFTs that equip items to fight
+	FighterDenom = "fighters"
+	// MaxEquipped is the maximum number of NFTs equipped directly onto a parent NFT
+	MaxEquipped = 8
+)
+
+// IsEquipped returns true if an NFT is equipped onto a parent NFT rather than owned by an account
+func IsEquipped(nft NFT) bool {
+	return nft.GetParent() != NFTRef{}
+}
+
+// ValidateEquip returns an error if an NFT of a collection can't be equipped onto an NFT of
+// the parent collection: items are equipped onto fighters or onto other items
+func ValidateEquip(child, parent NFTRef) error {
+	if child.Denom != ItemDenom {
+		return sdkerrors.Wrap(ErrInvalidEquipment, fmt.Sprintf("only NFTs of %s can be equipped", ItemDenom))
+	}
+	if parent.Denom != FighterDenom && parent.Denom != ItemDenom {
+		return sdkerrors.Wrap(ErrInvalidEquipment, fmt.Sprintf("NFTs can only be equipped onto %s or %s", FighterDenom, ItemDenom))
+	}
+	if child == parent {
+		return sdkerrors.Wrap(ErrEquipmentCycle, fmt.Sprintf("NFT %s can't be equipped onto itself", child))
+	}
+	return nil
+}
+
+// Equipment lists the NFTs equipped onto an NFT and its stats including their bonus
+type Equipment struct {
+	Denom    string   `json:"denom" yaml:"denom"`
+	ID       string   `json:"id" yaml:"id"`
+	Children []NFTRef `json:"children" yaml:"children"` // NFTs equipped directly onto the NFT
+	Stats    Stats    `json:"stats" yaml:"stats"`       // stats of the NFT with the bonus of all its equipment
+}
+
+// NewEquipment creates a new Equipment instance
+func NewEquipment(denom, id string, children []NFTRef, stats Stats) Equipment {
+	return Equipment{
+		Denom:    denom,
+		ID:       id,
+		Children: children,
+		Stats:    stats,
+	}
+}
+
+func (equipment Equipment) String() string {
+	return fmt.Sprintf(`NFT:      %s/%s
+Children: %s
+Stats:    %s`,
+		equipment.Denom,
+		equipment.ID,
+		NFTRefs(equipment.Children),
+		equipment.Stats,
+	)
+}
@@ -5,11 +5,47 @@ import (
 )
 
 var (
-	ErrInvalidCollection = sdkerrors.Register(ModuleName, 1, "invalid NFT collection")
-	ErrUnknownCollection = sdkerrors.Register(ModuleName, 2, "unknown NFT collection")
-	ErrInvalidNFT        = sdkerrors.Register(ModuleName, 3, "invalid NFT")
-	ErrUnknownNFT        = sdkerrors.Register(ModuleName, 4, "unknown NFT")
-	ErrNFTAlreadyExists  = sdkerrors.Register(ModuleName, 5, "NFT already exists")
-	ErrEmptyProof        = sdkerrors.Register(ModuleName, 6, "NFT proof can't be empty")
-	ErrEmptyMetadata     = sdkerrors.Register(ModuleName, 7, "Empty metadata")
+	ErrInvalidCollection     = sdkerrors.Register(ModuleName, 1, "invalid NFT collection")
+	ErrUnknownCollection     = sdkerrors.Register(ModuleName, 2, "unknown NFT collection")
+	ErrInvalidNFT            = sdkerrors.Register(ModuleName, 3, "invalid NFT")
+	ErrUnknownNFT            = sdkerrors.Register(ModuleName, 4, "unknown NFT")
+	ErrNFTAlreadyExists      = sdkerrors.Register(ModuleName, 5, "NFT already exists")
+	ErrEmptyProof            = sdkerrors.Register(ModuleName, 6, "NFT proof can't be empty")
+	ErrEmptyMetadata         = sdkerrors.Register(ModuleName, 7, "Empty metadata")
+	ErrInvalidProof          = sdkerrors.Register(ModuleName, 8, "invalid NFT proof")
+	ErrInvalidHash           = sdkerrors.Register(ModuleName, 9, "invalid NFT hash")
+	ErrHashMismatch          = sdkerrors.Register(ModuleName, 10, "NFT hash is not the blake3 hash of the proof")
+	ErrHashAlreadyExists     = sdkerrors.Register(ModuleName, 11, "NFT hash already exists")
+	ErrInvalidTrait          = sdkerrors.Register(ModuleName, 12, "invalid NFT trait")
+	ErrTraitNotInSchema      = sdkerrors.Register(ModuleName, 13, "NFT trait doesn't match the collection schema")
+	ErrCollectionExists      = sdkerrors.Register(ModuleName, 14, "NFT collection already exists")
+	ErrMetadataFrozen        = sdkerrors.Register(ModuleName, 15, "NFT metadata is frozen")
+	ErrImmutableField        = sdkerrors.Register(ModuleName, 16, "NFT field can't be changed after mint")
+	ErrInvalidCommitment     = sdkerrors.Register(ModuleName, 17, "invalid reveal commitment")
+	ErrRevealMismatch        = sdkerrors.Register(ModuleName, 18, "revealed metadata doesn't match the commitment")
+	ErrAlreadyRevealed       = sdkerrors.Register(ModuleName, 19, "NFT collection is already revealed")
+	ErrNotRevealed           = sdkerrors.Register(ModuleName, 20, "NFT collection is not revealed yet")
+	ErrNonTransferable       = sdkerrors.Register(ModuleName, 21, "NFT is not transferable")
+	ErrInvalidTransferPolicy = sdkerrors.Register(ModuleName, 22, "invalid NFT transfer policy")
+	ErrNotBurnable           = sdkerrors.Register(ModuleName, 23, "NFT can't be burned")
+	ErrIssuerFrozen          = sdkerrors.Register(ModuleName, 24, "NFT is frozen by the collection issuer")
+	ErrInvalidCapability     = sdkerrors.Register(ModuleName, 25, "invalid issuer capability")
+	ErrMissingCapability     = sdkerrors.Register(ModuleName, 26, "collection issuer doesn't have the capability")
+	ErrRented                = sdkerrors.Register(ModuleName, 27, "NFT is rented")
+	ErrInvalidRental         = sdkerrors.Register(ModuleName, 28, "invalid NFT rental")
+	ErrEscrowed              = sdkerrors.Register(ModuleName, 29, "NFT is held in escrow")
+	ErrInvalidLoan           = sdkerrors.Register(ModuleName, 30, "invalid NFT loan")
+	ErrUnknownLoan           = sdkerrors.Register(ModuleName, 31, "unknown NFT loan")
+	ErrInvalidVault          = sdkerrors.Register(ModuleName, 32, "invalid NFT vault")
+	ErrUnknownVault          = sdkerrors.Register(ModuleName, 33, "unknown NFT vault")
+	ErrInsufficientShares    = sdkerrors.Register(ModuleName, 34, "insufficient NFT vault shares")
+	ErrInvalidBundle         = sdkerrors.Register(ModuleName, 35, "invalid NFT bundle")
+	ErrUnknownBundle         = sdkerrors.Register(ModuleName, 36, "unknown NFT bundle")
+	ErrInvalidSwap           = sdkerrors.Register(ModuleName, 37, "invalid NFT swap")
+	ErrUnknownSwap           = sdkerrors.Register(ModuleName, 38, "unknown NFT swap")
+	ErrInvalidEquipment      = sdkerrors.Register(ModuleName, 39, "invalid NFT equipment")
+	ErrEquipmentCycle        = sdkerrors.Register(ModuleName, 40, "NFT can't be equipped onto its own equipment")
+	ErrUnknownMigration      = sdkerrors.Register(ModuleName, 41, "unknown store migration")
+	ErrInvalidEvent          = sdkerrors.Register(ModuleName, 42, "invalid NFT event")
+	ErrInvalidHistory        = sdkerrors.Register(ModuleName, 43, "invalid NFT history")
 )
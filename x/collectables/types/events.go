@@ -2,26 +2,88 @@ package types
 
 // NFT module event types
 var (
-	EventTypeSend            = "send_nft"
-	EventTypeEditNFTMetadata = "edit_nft_metadata"
-	EventTypeMintNFT         = "mint_nft"
-	EventTypeBuyNFT          = "buy_nft"
-	EventTypeEditNFTPrice    = "edit_nft_price"
-	EventTypeBurnNFT         = "burn_nft"
-	EventTypeChallengeNFT    = "challenge_nft"
+	EventTypeSend             = "send_nft"
+	EventTypeEditNFTMetadata  = "edit_nft_metadata"
+	EventTypeMintNFT          = "mint_nft"
+	EventTypeBuyNFT           = "buy_nft"
+	EventTypeEditNFTPrice     = "edit_nft_price"
+	EventTypeBurnNFT          = "burn_nft"
+	EventTypeChallengeNFT     = "challenge_nft"
+	EventTypeCreateCollection = "create_collection"
+	EventTypeFreezeMetadata   = "freeze_metadata"
+	EventTypeRevealCollection = "reveal_collection"
+	EventTypeFreezeNFT        = "freeze_nft"
+	EventTypeUnfreezeNFT      = "unfreeze_nft"
+	EventTypeClawbackNFT      = "clawback_nft"
+	EventTypeRentNFT          = "rent_nft"
+	EventTypeEndRental        = "end_rental"
+	EventTypeRequestLoan      = "request_loan"
+	EventTypeCancelLoan       = "cancel_loan"
+	EventTypeFundLoan         = "fund_loan"
+	EventTypeRepayLoan        = "repay_loan"
+	EventTypeLiquidateLoan    = "liquidate_loan"
+	EventTypeFractionalize    = "fractionalize"
+	EventTypeRedeem           = "redeem"
+	EventTypeBuyout           = "buyout"
+	EventTypeWrapNFTs         = "wrap_nfts"
+	EventTypeUnwrapBundle     = "unwrap_bundle"
+	EventTypeProposeSwap      = "propose_swap"
+	EventTypeAcceptSwap       = "accept_swap"
+	EventTypeCancelSwap       = "cancel_swap"
+	EventTypeEquip            = "equip"
+	EventTypeUnequip          = "unequip"
 
 	AttributeValueCategory = ModuleName
 
-	AttributeKeySender    = "sender"
-	AttributeKeyRecipient = "recipient"
-	AttributeKeyOwner     = "owner"
-	AttributeKeyNFTID     = "nft-id"
-	AttributeKeyNFTName   = "name"
-	AttributeKeyNFTHash   = "hash"
-	AttributeKeyNFTProof  = "proof"
-	AttributeKeyNFTWins   = "wins"
-	AttributeKeyNFTLosses = "losses"
-	AttributeKeyDenom     = "denom"
-	AttributeKeyNFTPrice  = "price"
-	AttributeKeyNFTWinner = "winner"
+	AttributeKeySender             = "sender"
+	AttributeKeyRecipient          = "recipient"
+	AttributeKeyOwner              = "owner"
+	AttributeKeyPreviousOwner      = "previous_owner"
+	AttributeKeyNewOwner           = "new_owner"
+	AttributeKeyPreviousPrice      = "previous_price"
+	AttributeKeyChallenger         = "challenger"
+	AttributeKeyContenderDenom     = "contender_denom"
+	AttributeKeyContenderID        = "contender_id"
+	AttributeKeyDefiantDenom       = "defiant_denom"
+	AttributeKeyDefiantID          = "defiant_id"
+	AttributeKeyCreator            = "creator"
+	AttributeKeyCommitment         = "commitment"
+	AttributeKeyTransferPolicy     = "transfer_policy"
+	AttributeKeyIssuer             = "issuer"
+	AttributeKeyIssuerCapabilities = "issuer_capabilities"
+	AttributeKeyUser               = "user"
+	AttributeKeyUserExpires        = "user_expires"
+	AttributeKeyFee                = "fee"
+	AttributeKeyLoanID             = "loan_id"
+	AttributeKeyBorrower           = "borrower"
+	AttributeKeyLender             = "lender"
+	AttributeKeyPrincipal          = "principal"
+	AttributeKeyInterest           = "interest"
+	AttributeKeyDueHeight          = "due_height"
+	AttributeKeyShareDenom         = "share_denom"
+	AttributeKeyShares             = "shares"
+	AttributeKeyReservePrice       = "reserve_price"
+	AttributeKeyPayout             = "payout"
+	AttributeKeyComponents         = "components"
+	AttributeKeySwapID             = "swap_id"
+	AttributeKeyProposer           = "proposer"
+	AttributeKeyCounterparty       = "counterparty"
+	AttributeKeyOfferedNFTs        = "offered_nfts"
+	AttributeKeyOfferedCoins       = "offered_coins"
+	AttributeKeyRequestedNFTs      = "requested_nfts"
+	AttributeKeyRequestedCoins     = "requested_coins"
+	AttributeKeyParentDenom        = "parent_denom"
+	AttributeKeyParentID           = "parent_id"
+	AttributeKeyNFTID              = "nft-id"
+	AttributeKeyNFTName            = "name"
+	AttributeKeyNFTHash            = "hash"
+	AttributeKeyNFTProof           = "proof"
+	AttributeKeyNFTWins            = "wins"
+	AttributeKeyNFTLosses          = "losses"
+	AttributeKeyDenom              = "denom"
+	AttributeKeyNFTPrice           = "price"
+	AttributeKeyNFTWinner          = "winner"
+	AttributeKeyNFTDescription     = "description"
+	AttributeKeyNFTTokenURI        = "token_uri"
+	AttributeKeyNFTTraits          = "traits"
 )
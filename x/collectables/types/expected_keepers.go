@@ -3,6 +3,7 @@ package types
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
+	supplyexported "github.com/cosmos/cosmos-sdk/x/supply/exported"
 )
 
 /*
@@ -12,8 +13,29 @@ as an interface so the module cannot use things that are not permitted.
 type BankKeeper interface {
 	SubtractCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Coins, error)
 	SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
+	GetCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+}
+
+// SupplyKeeper mints and burns the shares of fractionalized NFTs through the module account
+type SupplyKeeper interface {
+	GetSupply(ctx sdk.Context) supplyexported.SupplyI
+	MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
+	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 }
 
 type AccountKeeper interface {
 	GetAccount(ctx sdk.Context, addr sdk.AccAddress) authexported.Account
 }
+
+// CollectablesHooks event hooks for the lifecycle of NFTs, implemented by other modules to react
+// to them without parsing events
+type CollectablesHooks interface {
+	AfterMint(ctx sdk.Context, denom, id string, owner sdk.AccAddress)                          // Must be called when an NFT is minted
+	BeforeTransfer(ctx sdk.Context, denom, id string, from, to sdk.AccAddress)                  // Must be called before an NFT changes owner
+	AfterTransfer(ctx sdk.Context, denom, id string, from, to sdk.AccAddress)                   // Must be called after an NFT changed owner
+	AfterBurn(ctx sdk.Context, denom, id string, owner sdk.AccAddress)                          // Must be called when an NFT is burned
+	AfterChallenge(ctx sdk.Context, winner, loser NFTRef)                                       // Must be called when a challenge is resolved
+	AfterSale(ctx sdk.Context, denom, id string, seller, buyer sdk.AccAddress, price sdk.Coins) // Must be called when an NFT is bought
+}
@@ -0,0 +1,132 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+)
+
+// ShareDenomPrefix prefixes the denom of the shares of a vaulted NFT
+const ShareDenomPrefix = "frac"
+
+// GetShareDenom returns the bank denom of the shares of an NFT. Coin denoms are limited to
+// 16 lowercase alphanumeric characters so the collection denom and the NFT ID are hashed.
+func GetShareDenom(denom, id string) string {
+	sum := tmhash.Sum([]byte(denom + "/" + id))
+	return ShareDenomPrefix + hex.EncodeToString(sum)[:16-len(ShareDenomPrefix)]
+}
+
+// Vault holds an NFT in escrow while its ownership is split into fungible shares
+type Vault struct {
+	Denom        string         `json:"denom" yaml:"denom"`                 // collection of the vaulted NFT
+	NFTID        string         `json:"nft_id" yaml:"nft_id"`               // ID of the vaulted NFT
+	Curator      sdk.AccAddress `json:"curator" yaml:"curator"`             // account that fractionalized the NFT
+	ShareDenom   string         `json:"share_denom" yaml:"share_denom"`     // bank denom of the shares
+	Shares       sdk.Int        `json:"shares" yaml:"shares"`               // shares in circulation
+	ReservePrice sdk.Coins      `json:"reserve_price" yaml:"reserve_price"` // price to buy the NFT out, empty if it can't be bought out
+	Buyer        sdk.AccAddress `json:"buyer,omitempty" yaml:"buyer"`       // account that bought the NFT out
+	Proceeds     sdk.Coins      `json:"proceeds" yaml:"proceeds"`           // buyout proceeds not yet claimed by the shareholders
+}
+
+// NewVault creates a new vault for an NFT
+func NewVault(denom, nftID string, curator sdk.AccAddress, shares sdk.Int, reservePrice sdk.Coins) Vault {
+	denom = strings.TrimSpace(denom)
+	nftID = strings.TrimSpace(nftID)
+	return Vault{
+		Denom:        denom,
+		NFTID:        nftID,
+		Curator:      curator,
+		ShareDenom:   GetShareDenom(denom, nftID),
+		Shares:       shares,
+		ReservePrice: reservePrice,
+		Proceeds:     sdk.NewCoins(),
+	}
+}
+
+// IsBoughtOut returns true once the NFT has been bought out of the vault
+func (vault Vault) IsBoughtOut() bool {
+	return !vault.Buyer.Empty()
+}
+
+// SharesCoins returns an amount of shares of the vault as coins
+func (vault Vault) SharesCoins(amount sdk.Int) sdk.Coins {
+	return sdk.NewCoins(sdk.NewCoin(vault.ShareDenom, amount))
+}
+
+// Payout returns the part of the buyout proceeds owed for an amount of shares. Amounts are
+// rounded down, the holder of the last shares in circulation gets the remainder.
+func (vault Vault) Payout(shares sdk.Int) sdk.Coins {
+	if shares.GTE(vault.Shares) {
+		return vault.Proceeds
+	}
+	payout := sdk.NewCoins()
+	for _, coin := range vault.Proceeds {
+		payout = payout.Add(sdk.NewCoin(coin.Denom, coin.Amount.Mul(shares).Quo(vault.Shares)))
+	}
+	return payout
+}
+
+// Validate performs a basic validation of the vault
+func (vault Vault) Validate() error {
+	if vault.Curator.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid curator address")
+	}
+	if strings.TrimSpace(vault.Denom) == "" || strings.TrimSpace(vault.NFTID) == "" {
+		return sdkerrors.Wrap(ErrInvalidVault, "vault needs an NFT")
+	}
+	if vault.ShareDenom != GetShareDenom(vault.Denom, vault.NFTID) {
+		return sdkerrors.Wrap(ErrInvalidVault, fmt.Sprintf("share denom of NFT %s/%s isn't %s", vault.Denom, vault.NFTID, vault.ShareDenom))
+	}
+	// a missing amount decodes to a nil Int
+	if vault.Shares == (sdk.Int{}) || !vault.Shares.IsPositive() {
+		return sdkerrors.Wrap(ErrInvalidVault, "shares must be positive")
+	}
+	if !vault.ReservePrice.IsValid() {
+		return sdkerrors.Wrap(ErrInvalidVault, fmt.Sprintf("invalid reserve price %s", vault.ReservePrice))
+	}
+	if !vault.Proceeds.IsValid() {
+		return sdkerrors.Wrap(ErrInvalidVault, fmt.Sprintf("invalid proceeds %s", vault.Proceeds))
+	}
+	if !vault.IsBoughtOut() && !vault.Proceeds.IsZero() {
+		return sdkerrors.Wrap(ErrInvalidVault, "only a bought out vault has proceeds")
+	}
+	return nil
+}
+
+func (vault Vault) String() string {
+	return fmt.Sprintf(`NFT:          %s/%s
+Curator:      %s
+ShareDenom:   %s
+Shares:       %s
+ReservePrice: %s
+Buyer:        %s
+Proceeds:     %s`,
+		vault.Denom,
+		vault.NFTID,
+		vault.Curator,
+		vault.ShareDenom,
+		vault.Shares,
+		vault.ReservePrice,
+		vault.Buyer,
+		vault.Proceeds,
+	)
+}
+
+// Vaults is a list of vaults
+type Vaults []Vault
+
+func (vaults Vaults) String() string {
+	if len(vaults) == 0 {
+		return ""
+	}
+
+	out := ""
+	for _, vault := range vaults {
+		out += fmt.Sprintf("%v\n", vault.String())
+	}
+	return out[:len(out)-1]
+}
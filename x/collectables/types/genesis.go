@@ -1,35 +1,355 @@
 package types
 
 import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // GenesisState is the state that must be provided at genesis.
 type GenesisState struct {
-	Owners      []Owner     `json:"owners"`
-	Collections Collections `json:"collections"`
+	Owners       []Owner     `json:"owners"`
+	Collections  Collections `json:"collections"`
+	Loans        Loans       `json:"loans"`
+	NextLoanID   uint64      `json:"next_loan_id"`
+	Vaults       Vaults      `json:"vaults"`
+	Bundles      Bundles     `json:"bundles"`
+	NextBundleID uint64      `json:"next_bundle_id"`
+	Swaps        Swaps       `json:"swaps"`
+	NextSwapID   uint64      `json:"next_swap_id"`
+	Params       Params      `json:"params"`
+	History      History     `json:"history"`
+	Holders      []Holder    `json:"holders"`
 }
 
 // NewGenesisState creates a new genesis state.
-func NewGenesisState(owners []Owner, collections Collections) GenesisState {
+func NewGenesisState(owners []Owner, collections Collections, loans Loans, nextLoanID uint64,
+	vaults Vaults, bundles Bundles, nextBundleID uint64, swaps Swaps, nextSwapID uint64,
+	params Params, history History, holders []Holder) GenesisState {
 	return GenesisState{
-		Owners:      owners,
-		Collections: collections,
+		Owners:       owners,
+		Collections:  collections,
+		Loans:        loans,
+		NextLoanID:   nextLoanID,
+		Vaults:       vaults,
+		Bundles:      bundles,
+		NextBundleID: nextBundleID,
+		Swaps:        swaps,
+		NextSwapID:   nextSwapID,
+		Params:       params,
+		History:      history,
+		Holders:      holders,
 	}
 }
 
 // DefaultGenesisState returns a default genesis state
 func DefaultGenesisState() GenesisState {
-	return NewGenesisState([]Owner{}, NewCollections())
+	return NewGenesisState([]Owner{}, NewCollections(), Loans{}, 1, Vaults{}, Bundles{}, 1, Swaps{}, 1, DefaultParams(), History{}, []Holder{})
 }
 
 // ValidateGenesis performs basic validation of nfts genesis data returning an
-// error for any failed validation criteria.
+// error for any failed validation criteria. The owners and holders may be omitted,
+// they are then derived from the collections at genesis.
 func ValidateGenesis(data GenesisState) error {
-	for _, Owner := range data.Owners {
-		if Owner.Address.Empty() {
-			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "address cannot be empty")
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	nfts, err := validateCollections(data.Collections)
+	if err != nil {
+		return err
+	}
+	if len(data.Owners) != 0 {
+		if err := validateOwners(data.Owners, nfts); err != nil {
+			return err
+		}
+	}
+	if len(data.Holders) != 0 {
+		if err := validateHolders(data.Holders, nfts); err != nil {
+			return err
+		}
+	}
+
+	escrowed := make(map[NFTRef]bool)
+	for _, loan := range data.Loans {
+		if err := loan.Validate(); err != nil {
+			return err
+		}
+		if loan.ID == 0 || loan.ID >= data.NextLoanID {
+			return sdkerrors.Wrap(ErrInvalidLoan, fmt.Sprintf("loan %d isn't below the next loan ID %d", loan.ID, data.NextLoanID))
+		}
+		ref := NewNFTRef(loan.Denom, loan.NFTID)
+		if escrowed[ref] {
+			return sdkerrors.Wrap(ErrInvalidLoan, fmt.Sprintf("NFT %s is the collateral of several loans", ref))
+		}
+		escrowed[ref] = true
+	}
+
+	vaulted := make(map[NFTRef]bool)
+	for _, vault := range data.Vaults {
+		if err := vault.Validate(); err != nil {
+			return err
+		}
+		ref := NewNFTRef(vault.Denom, vault.NFTID)
+		if vaulted[ref] {
+			return sdkerrors.Wrap(ErrInvalidVault, fmt.Sprintf("NFT %s has several vaults", ref))
+		}
+		vaulted[ref] = true
+		if !vault.IsBoughtOut() {
+			if escrowed[ref] {
+				return sdkerrors.Wrap(ErrInvalidVault, fmt.Sprintf("NFT %s is both vaulted and the collateral of a loan", ref))
+			}
+			escrowed[ref] = true
+		}
+	}
+
+	bundles := make(map[string]bool)
+	for _, bundle := range data.Bundles {
+		if err := bundle.Validate(); err != nil {
+			return err
+		}
+		if id, err := strconv.ParseUint(bundle.ID, 10, 64); err != nil || id == 0 || id >= data.NextBundleID {
+			return sdkerrors.Wrap(ErrInvalidBundle, fmt.Sprintf("bundle %s isn't below the next bundle ID %d", bundle.ID, data.NextBundleID))
+		}
+		if bundles[bundle.ID] {
+			return sdkerrors.Wrap(ErrInvalidBundle, fmt.Sprintf("duplicate bundle %s", bundle.ID))
+		}
+		bundles[bundle.ID] = true
+		for _, ref := range bundle.Components {
+			if escrowed[ref] {
+				return sdkerrors.Wrap(ErrInvalidBundle, fmt.Sprintf("NFT %s of bundle %s is already locked", ref, bundle.ID))
+			}
+			escrowed[ref] = true
+		}
+	}
+
+	swaps := make(map[uint64]bool)
+	for _, swap := range data.Swaps {
+		if err := swap.Validate(); err != nil {
+			return err
+		}
+		if swap.ID == 0 || swap.ID >= data.NextSwapID {
+			return sdkerrors.Wrap(ErrInvalidSwap, fmt.Sprintf("swap %d isn't below the next swap ID %d", swap.ID, data.NextSwapID))
+		}
+		if swaps[swap.ID] {
+			return sdkerrors.Wrap(ErrInvalidSwap, fmt.Sprintf("duplicate swap %d", swap.ID))
+		}
+		swaps[swap.ID] = true
+		for _, ref := range swap.OfferedNFTs {
+			if escrowed[ref] {
+				return sdkerrors.Wrap(ErrInvalidSwap, fmt.Sprintf("NFT %s offered by swap %d is already locked", ref, swap.ID))
+			}
+			escrowed[ref] = true
+		}
+	}
+
+	for ref := range escrowed {
+		if _, ok := nfts[ref]; !ok {
+			return sdkerrors.Wrap(ErrUnknownNFT, fmt.Sprintf("locked NFT %s doesn't exist", ref))
+		}
+	}
+	for id := range bundles {
+		if _, ok := nfts[NewNFTRef(BundleDenom, id)]; !ok {
+			return sdkerrors.Wrap(ErrInvalidBundle, fmt.Sprintf("NFT of bundle %s doesn't exist", id))
+		}
+	}
+
+	// the history is kept once an NFT is burned so its entries don't have to reference existing NFTs
+	type historyKey struct {
+		ref      NFTRef
+		sequence uint64
+	}
+	history := make(map[historyKey]bool)
+	for _, entry := range data.History {
+		if err := entry.Validate(); err != nil {
+			return err
+		}
+		key := historyKey{NewNFTRef(entry.Denom, entry.ID), entry.Sequence}
+		if history[key] {
+			return sdkerrors.Wrap(ErrInvalidHistory, fmt.Sprintf("duplicate entry %d in the history of NFT %s", entry.Sequence, key.ref))
 		}
+		history[key] = true
+	}
+
+	parents := make(map[NFTRef]NFTRef)
+	for _, collection := range data.Collections {
+		for _, nft := range collection.NFTs {
+			ref := NewNFTRef(collection.Denom, nft.GetID())
+			if !IsEquipped(nft) {
+				continue
+			}
+			if err := ValidateEquip(ref, nft.GetParent()); err != nil {
+				return err
+			}
+			if escrowed[ref] {
+				return sdkerrors.Wrap(ErrInvalidEquipment, fmt.Sprintf("equipped NFT %s is already locked", ref))
+			}
+			parents[ref] = nft.GetParent()
+		}
+	}
+	for child, parent := range parents {
+		if _, ok := nfts[parent]; !ok {
+			return sdkerrors.Wrap(ErrInvalidEquipment, fmt.Sprintf("NFT %s is equipped onto %s which doesn't exist", child, parent))
+		}
+		// a chain of parents longer than the number of equipped NFTs goes around a cycle
+		for i := 0; i < len(parents); i++ {
+			next, ok := parents[parent]
+			if !ok {
+				break
+			}
+			if next == child {
+				return sdkerrors.Wrap(ErrEquipmentCycle, fmt.Sprintf("NFT %s is equipped below itself", child))
+			}
+			parent = next
+		}
+	}
+	return nil
+}
+
+// validateCollections checks that the collections have distinct denoms and that their NFTs have
+// distinct IDs and hashes, valid owners and metadata and a hash matching their proof. It returns all the NFTs.
+func validateCollections(collections Collections) (map[NFTRef]NFT, error) {
+	nfts := make(map[NFTRef]NFT)
+	denoms := make(map[string]bool)
+	for _, collection := range collections {
+		if strings.TrimSpace(collection.Denom) == "" {
+			return nil, sdkerrors.Wrap(ErrInvalidCollection, "collection needs a denom")
+		}
+		if denoms[collection.Denom] {
+			return nil, sdkerrors.Wrap(ErrInvalidCollection, fmt.Sprintf("duplicate collection %s", collection.Denom))
+		}
+		denoms[collection.Denom] = true
+
+		hashes := make(map[string]string)
+		for _, nft := range collection.NFTs {
+			if strings.TrimSpace(nft.GetID()) == "" {
+				return nil, sdkerrors.Wrap(ErrInvalidNFT, fmt.Sprintf("NFT of collection %s needs an ID", collection.Denom))
+			}
+			ref := NewNFTRef(collection.Denom, nft.GetID())
+			if _, ok := nfts[ref]; ok {
+				return nil, sdkerrors.Wrap(ErrNFTAlreadyExists, fmt.Sprintf("duplicate NFT %s", ref))
+			}
+			nfts[ref] = nft
+			if err := validateAddress(nft.GetOwner()); err != nil {
+				return nil, sdkerrors.Wrap(err, fmt.Sprintf("owner of NFT %s", ref))
+			}
+			if !nft.GetCreator().Empty() {
+				if err := validateAddress(nft.GetCreator()); err != nil {
+					return nil, sdkerrors.Wrap(err, fmt.Sprintf("creator of NFT %s", ref))
+				}
+			}
+			if err := ValidateProofHash(collection.Denom, nft); err != nil {
+				return nil, err
+			}
+			if err := ValidateMetadata(nft.GetDescription(), nft.GetTokenURI(), nft.GetTraits()); err != nil {
+				return nil, sdkerrors.Wrap(err, fmt.Sprintf("metadata of NFT %s", ref))
+			}
+			if !sort.IsSorted(nft.GetTraits()) {
+				return nil, sdkerrors.Wrap(ErrInvalidTrait, fmt.Sprintf("traits of NFT %s aren't sorted by key", ref))
+			}
+			if id, ok := hashes[nft.GetHash()]; ok {
+				return nil, sdkerrors.Wrap(ErrHashAlreadyExists,
+					fmt.Sprintf("NFTs #%s and #%s of collection %s share the hash %s", id, nft.GetID(), collection.Denom, nft.GetHash()),
+				)
+			}
+			hashes[nft.GetHash()] = nft.GetID()
+		}
+	}
+	return nfts, nil
+}
+
+// validateOwners checks that the owners records list every NFT exactly once, under the address owning it
+func validateOwners(owners []Owner, nfts map[NFTRef]NFT) error {
+	addresses := make(map[string]bool)
+	recorded := make(map[NFTRef]bool)
+	for _, owner := range owners {
+		if err := validateAddress(owner.Address); err != nil {
+			return err
+		}
+		if addresses[owner.Address.String()] {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("duplicate owner %s", owner.Address))
+		}
+		addresses[owner.Address.String()] = true
+
+		denoms := make(map[string]bool)
+		for _, idCollection := range owner.IDCollections {
+			if denoms[idCollection.Denom] {
+				return sdkerrors.Wrap(ErrInvalidCollection,
+					fmt.Sprintf("owner %s has several ID collections for %s", owner.Address, idCollection.Denom),
+				)
+			}
+			denoms[idCollection.Denom] = true
+			if idCollection.Supply() == 0 {
+				return sdkerrors.Wrap(ErrInvalidCollection,
+					fmt.Sprintf("owner %s has an empty ID collection for %s", owner.Address, idCollection.Denom),
+				)
+			}
+			for _, id := range idCollection.IDs {
+				ref := NewNFTRef(idCollection.Denom, id)
+				if recorded[ref] {
+					return sdkerrors.Wrap(ErrInvalidNFT, fmt.Sprintf("NFT %s is recorded several times", ref))
+				}
+				recorded[ref] = true
+				nft, ok := nfts[ref]
+				if !ok {
+					return sdkerrors.Wrap(ErrUnknownNFT, fmt.Sprintf("owner %s holds NFT %s which doesn't exist", owner.Address, ref))
+				}
+				if !nft.GetOwner().Equals(owner.Address) {
+					return sdkerrors.Wrap(ErrInvalidNFT,
+						fmt.Sprintf("owner %s holds NFT %s which is owned by %s", owner.Address, ref, nft.GetOwner()),
+					)
+				}
+			}
+		}
+	}
+	for ref, nft := range nfts {
+		if !recorded[ref] {
+			return sdkerrors.Wrap(ErrInvalidNFT, fmt.Sprintf("NFT %s isn't recorded for its owner %s", ref, nft.GetOwner()))
+		}
+	}
+	return nil
+}
+
+// validateHolders checks that the holders records are unique and list every NFT under the address
+// owning it, the NFTs held in the past may have been burned since
+func validateHolders(holders []Holder, nfts map[NFTRef]NFT) error {
+	addresses := make(map[string]bool)
+	held := make(map[string]bool)
+	for _, holder := range holders {
+		if err := validateAddress(holder.Address); err != nil {
+			return sdkerrors.Wrap(err, "holder")
+		}
+		if addresses[holder.Address.String()] {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("duplicate holder %s", holder.Address))
+		}
+		addresses[holder.Address.String()] = true
+		for _, ref := range holder.NFTs {
+			key := holder.Address.String() + "/" + ref.String()
+			if held[key] {
+				return sdkerrors.Wrap(ErrInvalidNFT, fmt.Sprintf("NFT %s is recorded twice for holder %s", ref, holder.Address))
+			}
+			held[key] = true
+		}
+	}
+	for ref, nft := range nfts {
+		if !held[nft.GetOwner().String()+"/"+ref.String()] {
+			return sdkerrors.Wrap(ErrInvalidNFT, fmt.Sprintf("owner %s isn't recorded as a holder of NFT %s", nft.GetOwner(), ref))
+		}
+	}
+	return nil
+}
+
+// validateAddress checks that an address is set and has a valid format
+func validateAddress(address sdk.AccAddress) error {
+	if address.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "address cannot be empty")
+	}
+	if err := sdk.VerifyAddressFormat(address); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
 	}
 	return nil
 }
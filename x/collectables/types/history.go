@@ -0,0 +1,132 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// HistoryAction is the action recorded by an entry of the history of an NFT
+type HistoryAction string
+
+// Actions recorded in the history of an NFT
+const (
+	HistoryActionMint         HistoryAction = "mint"          // the NFT was minted to the new owner
+	HistoryActionTransfer     HistoryAction = "transfer"      // the NFT changed hands without a sale
+	HistoryActionSale         HistoryAction = "sale"          // the NFT was bought from its previous owner
+	HistoryActionBurn         HistoryAction = "burn"          // the NFT was burned by its owner
+	HistoryActionChallenge    HistoryAction = "challenge"     // the NFT fought another NFT
+	HistoryActionEditMetadata HistoryAction = "edit_metadata" // the metadata of the NFT was edited by its owner
+)
+
+// Outcomes of a challenge recorded in the history of an NFT
+const (
+	ChallengeOutcomeWon  = "won"
+	ChallengeOutcomeLost = "lost"
+)
+
+// HistoryEntry is an entry of the append-only history of an NFT, the fields which don't
+// apply to its action are empty
+type HistoryEntry struct {
+	Denom    string         `json:"denom" yaml:"denom"`
+	ID       string         `json:"id" yaml:"id"`
+	Sequence uint64         `json:"sequence" yaml:"sequence"` // position of the entry in the history of the NFT
+	Height   int64          `json:"height" yaml:"height"`     // block height of the action
+	Action   HistoryAction  `json:"action" yaml:"action"`
+	From     sdk.AccAddress `json:"from" yaml:"from"`         // previous owner, or the owner acting on the NFT
+	To       sdk.AccAddress `json:"to" yaml:"to"`             // new owner
+	Price    sdk.Coins      `json:"price" yaml:"price"`       // amount paid for a sale
+	Opponent NFTRef         `json:"opponent" yaml:"opponent"` // NFT fought in a challenge
+	Outcome  string         `json:"outcome" yaml:"outcome"`   // outcome of a challenge
+}
+
+// NewMintEntry creates the history entry of a mint
+func NewMintEntry(owner sdk.AccAddress) HistoryEntry {
+	return HistoryEntry{Action: HistoryActionMint, To: owner}
+}
+
+// NewTransferEntry creates the history entry of a transfer
+func NewTransferEntry(from, to sdk.AccAddress) HistoryEntry {
+	return HistoryEntry{Action: HistoryActionTransfer, From: from, To: to}
+}
+
+// NewSaleEntry creates the history entry of a sale
+func NewSaleEntry(seller, buyer sdk.AccAddress, price sdk.Coins) HistoryEntry {
+	return HistoryEntry{Action: HistoryActionSale, From: seller, To: buyer, Price: price}
+}
+
+// NewBurnEntry creates the history entry of a burn
+func NewBurnEntry(owner sdk.AccAddress) HistoryEntry {
+	return HistoryEntry{Action: HistoryActionBurn, From: owner}
+}
+
+// NewChallengeEntry creates the history entry of a challenge against an opponent
+func NewChallengeEntry(opponent NFTRef, won bool) HistoryEntry {
+	outcome := ChallengeOutcomeLost
+	if won {
+		outcome = ChallengeOutcomeWon
+	}
+	return HistoryEntry{Action: HistoryActionChallenge, Opponent: opponent, Outcome: outcome}
+}
+
+// NewEditMetadataEntry creates the history entry of a metadata edit
+func NewEditMetadataEntry(owner sdk.AccAddress) HistoryEntry {
+	return HistoryEntry{Action: HistoryActionEditMetadata, From: owner}
+}
+
+// Validate checks that an entry references an NFT and records a known action
+func (entry HistoryEntry) Validate() error {
+	if strings.TrimSpace(entry.Denom) == "" || strings.TrimSpace(entry.ID) == "" {
+		return sdkerrors.Wrap(ErrInvalidHistory, "history entry doesn't reference an NFT")
+	}
+	switch entry.Action {
+	case HistoryActionMint, HistoryActionTransfer, HistoryActionSale, HistoryActionBurn,
+		HistoryActionChallenge, HistoryActionEditMetadata:
+	default:
+		return sdkerrors.Wrap(ErrInvalidHistory, fmt.Sprintf("unknown history action %s", entry.Action))
+	}
+	if !entry.Price.IsValid() {
+		return sdkerrors.Wrap(ErrInvalidHistory, fmt.Sprintf("invalid price %s", entry.Price))
+	}
+	return nil
+}
+
+func (entry HistoryEntry) String() string {
+	return fmt.Sprintf(`NFT:      %s/%s
+Sequence: %d
+Height:   %d
+Action:   %s
+From:     %s
+To:       %s
+Price:    %s
+Opponent: %s
+Outcome:  %s`,
+		entry.Denom,
+		entry.ID,
+		entry.Sequence,
+		entry.Height,
+		entry.Action,
+		entry.From,
+		entry.To,
+		entry.Price,
+		entry.Opponent,
+		entry.Outcome,
+	)
+}
+
+// History is the history of an NFT, from the oldest entry kept to the newest
+type History []HistoryEntry
+
+func (history History) String() string {
+	if len(history) == 0 {
+		return ""
+	}
+
+	out := ""
+	for _, entry := range history {
+		out += fmt.Sprintf("%v\n", entry.String())
+	}
+	return out[:len(out)-1]
+}
@@ -0,0 +1,61 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Holder lists the NFTs an account has ever held, including those it doesn't own anymore
+type Holder struct {
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+	NFTs    []NFTRef       `json:"nfts" yaml:"nfts"`
+}
+
+// NewHolder creates a new Holder instance
+func NewHolder(address sdk.AccAddress, nfts ...NFTRef) Holder {
+	return Holder{
+		Address: address,
+		NFTs:    nfts,
+	}
+}
+
+// String follows stringer interface
+func (holder Holder) String() string {
+	return fmt.Sprintf(`Address: %s
+NFTs:    %v`,
+		holder.Address,
+		holder.NFTs,
+	)
+}
+
+// DeriveHolders returns the current owners of the NFTs of collections as their holders, sorted by address
+func DeriveHolders(collections Collections) []Holder {
+	var addresses []sdk.AccAddress
+	nfts := make(map[string][]NFTRef)
+	for _, collection := range collections {
+		for _, nft := range collection.NFTs {
+			address := nft.GetOwner()
+			if _, ok := nfts[address.String()]; !ok {
+				addresses = append(addresses, address)
+			}
+			nfts[address.String()] = append(nfts[address.String()], NewNFTRef(collection.Denom, nft.GetID()))
+		}
+	}
+	sort.Slice(addresses, func(i, j int) bool { return bytes.Compare(addresses[i], addresses[j]) < 0 })
+
+	holders := make([]Holder, len(addresses))
+	for i, address := range addresses {
+		refs := nfts[address.String()]
+		sort.Slice(refs, func(i, j int) bool {
+			if refs[i].Denom != refs[j].Denom {
+				return refs[i].Denom < refs[j].Denom
+			}
+			return refs[i].ID < refs[j].ID
+		})
+		holders[i] = NewHolder(address, refs...)
+	}
+	return holders
+}
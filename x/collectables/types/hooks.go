@@ -0,0 +1,57 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MultiCollectablesHooks combines multiple collectables hooks, all hook functions are run in array sequence
+type MultiCollectablesHooks []CollectablesHooks
+
+var _ CollectablesHooks = MultiCollectablesHooks{}
+
+// NewMultiCollectablesHooks creates a new MultiCollectablesHooks instance
+func NewMultiCollectablesHooks(hooks ...CollectablesHooks) MultiCollectablesHooks {
+	return hooks
+}
+
+// AfterMint implements CollectablesHooks
+func (h MultiCollectablesHooks) AfterMint(ctx sdk.Context, denom, id string, owner sdk.AccAddress) {
+	for i := range h {
+		h[i].AfterMint(ctx, denom, id, owner)
+	}
+}
+
+// BeforeTransfer implements CollectablesHooks
+func (h MultiCollectablesHooks) BeforeTransfer(ctx sdk.Context, denom, id string, from, to sdk.AccAddress) {
+	for i := range h {
+		h[i].BeforeTransfer(ctx, denom, id, from, to)
+	}
+}
+
+// AfterTransfer implements CollectablesHooks
+func (h MultiCollectablesHooks) AfterTransfer(ctx sdk.Context, denom, id string, from, to sdk.AccAddress) {
+	for i := range h {
+		h[i].AfterTransfer(ctx, denom, id, from, to)
+	}
+}
+
+// AfterBurn implements CollectablesHooks
+func (h MultiCollectablesHooks) AfterBurn(ctx sdk.Context, denom, id string, owner sdk.AccAddress) {
+	for i := range h {
+		h[i].AfterBurn(ctx, denom, id, owner)
+	}
+}
+
+// AfterChallenge implements CollectablesHooks
+func (h MultiCollectablesHooks) AfterChallenge(ctx sdk.Context, winner, loser NFTRef) {
+	for i := range h {
+		h[i].AfterChallenge(ctx, winner, loser)
+	}
+}
+
+// AfterSale implements CollectablesHooks
+func (h MultiCollectablesHooks) AfterSale(ctx sdk.Context, denom, id string, seller, buyer sdk.AccAddress, price sdk.Coins) {
+	for i := range h {
+		h[i].AfterSale(ctx, denom, id, seller, buyer, price)
+	}
+}
@@ -0,0 +1,72 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// IssuerCapability is a compliance power the issuer of a collection holds over its NFTs
+type IssuerCapability string
+
+// Issuer capabilities that can be declared when a collection is created
+const (
+	IssuerCapabilityFreeze   IssuerCapability = "freeze"   // the issuer can stop an NFT from changing hands
+	IssuerCapabilityClawback IssuerCapability = "clawback" // the issuer can move an NFT to another account
+)
+
+// ParseIssuerCapability parses an issuer capability
+func ParseIssuerCapability(s string) (IssuerCapability, error) {
+	capability := IssuerCapability(strings.ToLower(strings.TrimSpace(s)))
+	return capability, capability.Validate()
+}
+
+// Validate checks that a capability is known
+func (capability IssuerCapability) Validate() error {
+	switch capability {
+	case IssuerCapabilityFreeze, IssuerCapabilityClawback:
+		return nil
+	default:
+		return sdkerrors.Wrap(ErrInvalidCapability,
+			fmt.Sprintf("unknown issuer capability %s, expected %s or %s",
+				capability, IssuerCapabilityFreeze, IssuerCapabilityClawback),
+		)
+	}
+}
+
+// IssuerCapabilities is the set of capabilities of the issuer of a collection
+type IssuerCapabilities []IssuerCapability
+
+// Has returns true if the capability is part of the set
+func (capabilities IssuerCapabilities) Has(capability IssuerCapability) bool {
+	for _, c := range capabilities {
+		if c == capability {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate checks that every capability is known and declared once
+func (capabilities IssuerCapabilities) Validate() error {
+	seen := make(map[IssuerCapability]bool, len(capabilities))
+	for _, c := range capabilities {
+		if err := c.Validate(); err != nil {
+			return err
+		}
+		if seen[c] {
+			return sdkerrors.Wrap(ErrInvalidCapability, fmt.Sprintf("duplicate issuer capability %s", c))
+		}
+		seen[c] = true
+	}
+	return nil
+}
+
+func (capabilities IssuerCapabilities) String() string {
+	out := make([]string, len(capabilities))
+	for i, c := range capabilities {
+		out[i] = string(c)
+	}
+	return strings.Join(out, ",")
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"encoding/binary"
 	"fmt"
 
 	"github.com/tendermint/tendermint/crypto/tmhash"
@@ -20,16 +21,71 @@ const (
 
 	// QuerierRoute to be used for querierer msgs
 	QuerierRoute = ModuleName
+
+	// ConsensusVersion is the version of the store layout written by this release,
+	// stores of older versions are migrated in place by the keeper
+	ConsensusVersion uint64 = 5
 )
 
-// NFTs are stored as follow:
+// NFTs are stored as follow, the records are protobuf encoded since v5:
 //
 // - Colections: 0x00<denom_bytes_key> :<Collection>
 //
 // - Owners: 0x01<address_bytes_key><denom_bytes_key>: <Owner>
+//
+// - Hashes: 0x02<hash_bytes_key><denom_bytes_key>: <NFTRef>
+//
+// - Trait counts: 0x03<denom_bytes_key><trait_key_bytes>0x00<trait_value_bytes>: <uint64>
+//
+// - Rental expiries: 0x04<height_bytes><denom_bytes_key><id_bytes>: <NFTRef>
+//
+// - Loans: 0x05<loan_id_bytes>: <Loan>
+//
+// - Loan due heights: 0x06<height_bytes><loan_id_bytes>: <loan_id_bytes>
+//
+// - Next loan ID: 0x07: <loan_id_bytes>
+//
+// - Vaults: 0x08<denom_bytes_key><id_bytes>: <Vault>
+//
+// - Bundles: 0x09<bundle_id_bytes>: <Bundle>
+//
+// - Next bundle ID: 0x0A: <bundle_id_bytes>
+//
+// - Swaps: 0x0B<swap_id_bytes>: <Swap>
+//
+// - Next swap ID: 0x0C: <swap_id_bytes>
+//
+// - Equipment: 0x0D<parent_denom_bytes_key><parent_id_bytes>0x00<child_denom_bytes_key><child_id_bytes>: <NFTRef>
+//
+// - Store version: 0x0E: <version_bytes>
+//
+// - History: 0x0F<denom_bytes_key><id_bytes>0x00<sequence_bytes>: <HistoryEntry>
+//
+// - Creators: 0x10<address_bytes_key><denom_bytes_key><id_bytes>: <NFTRef>
+//
+// - Holders: 0x11<address_bytes_key><denom_bytes_key><id_bytes>: <NFTRef>
+//
+// - Traits: 0x12<denom_bytes_key><trait_key_bytes>0x00<trait_value_bytes>0x00<id_bytes>: <NFTRef>
 var (
 	CollectionsKeyPrefix = []byte{0x00} // key for NFT collections
 	OwnersKeyPrefix      = []byte{0x01} // key for balance of NFTs held by an address
+	HashesKeyPrefix      = []byte{0x02} // key for the NFT referenced by a proof hash
+	TraitCountsKeyPrefix = []byte{0x03} // key for the number of NFTs of a collection having a trait
+	RentalsKeyPrefix     = []byte{0x04} // key for the NFTs whose rental expires at a block height
+	LoansKeyPrefix       = []byte{0x05} // key for NFT collateralized loans
+	LoanDuesKeyPrefix    = []byte{0x06} // key for the funded loans due at a block height
+	NextLoanIDKey        = []byte{0x07} // key for the ID of the next loan
+	VaultsKeyPrefix      = []byte{0x08} // key for the vaults of fractionalized NFTs
+	BundlesKeyPrefix     = []byte{0x09} // key for the NFTs wrapped by bundles
+	NextBundleIDKey      = []byte{0x0A} // key for the ID of the next bundle
+	SwapsKeyPrefix       = []byte{0x0B} // key for the swap offers
+	NextSwapIDKey        = []byte{0x0C} // key for the ID of the next swap
+	EquipmentKeyPrefix   = []byte{0x0D} // key for the NFTs equipped onto a parent NFT
+	StoreVersionKey      = []byte{0x0E} // key for the version of the store layout
+	HistoryKeyPrefix     = []byte{0x0F} // key for the history entries of NFTs
+	CreatorsKeyPrefix    = []byte{0x10} // key for the NFTs minted by an address
+	HoldersKeyPrefix     = []byte{0x11} // key for the NFTs ever held by an address
+	TraitsKeyPrefix      = []byte{0x12} // key for the NFTs of a collection having a trait
 )
 
 // GetCollectionKey gets the key of a collection
@@ -70,3 +126,208 @@ func GetOwnerKey(address sdk.AccAddress, denom string) []byte {
 
 	return append(GetOwnersKey(address), bs...)
 }
+
+// GetHashesKey gets the key prefix for all the NFTs minted with a hash
+func GetHashesKey(hash string) []byte {
+	return append(HashesKeyPrefix, []byte(hash)...)
+}
+
+// GetHashKey gets the key of the NFT minted with a hash within a collection
+func GetHashKey(hash, denom string) []byte {
+	h := tmhash.New()
+	_, err := h.Write([]byte(denom))
+	if err != nil {
+		panic(err)
+	}
+	bs := h.Sum(nil)
+
+	return append(GetHashesKey(hash), bs...)
+}
+
+// GetTraitCountsKey gets the key prefix for the trait counts of a collection
+func GetTraitCountsKey(denom string) []byte {
+	h := tmhash.New()
+	_, err := h.Write([]byte(denom))
+	if err != nil {
+		panic(err)
+	}
+	bs := h.Sum(nil)
+
+	return append(TraitCountsKeyPrefix, bs...)
+}
+
+// GetTraitCountKey gets the key of the count of a trait value within a collection
+func GetTraitCountKey(denom string, trait Trait) []byte {
+	key := append(GetTraitCountsKey(denom), []byte(trait.Key)...)
+	key = append(key, 0x00)
+	return append(key, []byte(trait.Value)...)
+}
+
+// GetTraitNFTsKey gets the key prefix for the NFTs of a collection having a trait value
+func GetTraitNFTsKey(denom string, trait Trait) []byte {
+	h := tmhash.New()
+	_, err := h.Write([]byte(denom))
+	if err != nil {
+		panic(err)
+	}
+	bs := h.Sum(nil)
+
+	key := append(append(TraitsKeyPrefix, bs...), []byte(trait.Key)...)
+	key = append(key, 0x00)
+	key = append(key, []byte(trait.Value)...)
+	return append(key, 0x00)
+}
+
+// GetTraitNFTKey gets the key of an NFT of a collection having a trait value
+func GetTraitNFTKey(denom string, trait Trait, id string) []byte {
+	return append(GetTraitNFTsKey(denom, trait), []byte(id)...)
+}
+
+// GetRentalsKey gets the key prefix for all the rentals expiring at a block height
+func GetRentalsKey(height int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(height))
+	return append(RentalsKeyPrefix, bz...)
+}
+
+// GetRentalKey gets the key of the rental of an NFT expiring at a block height
+func GetRentalKey(height int64, denom, id string) []byte {
+	h := tmhash.New()
+	_, err := h.Write([]byte(denom))
+	if err != nil {
+		panic(err)
+	}
+	bs := h.Sum(nil)
+
+	return append(append(GetRentalsKey(height), bs...), []byte(id)...)
+}
+
+// SplitRentalKey gets the block height a rental ends at from its key
+func SplitRentalKey(key []byte) int64 {
+	if len(key) < 1+8+tmhash.Size {
+		panic(fmt.Sprintf("unexpected key length %d", len(key)))
+	}
+	return int64(binary.BigEndian.Uint64(key[1:9]))
+}
+
+// GetLoanKey gets the key of a loan
+func GetLoanKey(id uint64) []byte {
+	return append(LoansKeyPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetLoanDuesKey gets the key prefix for all the loans due at a block height
+func GetLoanDuesKey(height int64) []byte {
+	return append(LoanDuesKeyPrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetLoanDueKey gets the key of a loan due at a block height
+func GetLoanDueKey(height int64, id uint64) []byte {
+	return append(GetLoanDuesKey(height), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetVaultKey gets the key of the vault of an NFT
+func GetVaultKey(denom, id string) []byte {
+	h := tmhash.New()
+	_, err := h.Write([]byte(denom))
+	if err != nil {
+		panic(err)
+	}
+	bs := h.Sum(nil)
+
+	return append(append(VaultsKeyPrefix, bs...), []byte(id)...)
+}
+
+// GetBundleKey gets the key of a bundle
+func GetBundleKey(id string) []byte {
+	return append(BundlesKeyPrefix, []byte(id)...)
+}
+
+// GetSwapKey gets the key of a swap
+func GetSwapKey(id uint64) []byte {
+	return append(SwapsKeyPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetEquipmentKey gets the key prefix for all the NFTs equipped onto a parent NFT
+func GetEquipmentKey(parentDenom, parentID string) []byte {
+	h := tmhash.New()
+	_, err := h.Write([]byte(parentDenom))
+	if err != nil {
+		panic(err)
+	}
+	bs := h.Sum(nil)
+
+	key := append(append(EquipmentKeyPrefix, bs...), []byte(parentID)...)
+	return append(key, 0x00)
+}
+
+// GetEquippedKey gets the key of an NFT equipped onto a parent NFT
+func GetEquippedKey(parent, child NFTRef) []byte {
+	h := tmhash.New()
+	_, err := h.Write([]byte(child.Denom))
+	if err != nil {
+		panic(err)
+	}
+	bs := h.Sum(nil)
+
+	return append(append(GetEquipmentKey(parent.Denom, parent.ID), bs...), []byte(child.ID)...)
+}
+
+// GetHistoryKey gets the key prefix for the history entries of an NFT
+func GetHistoryKey(denom, id string) []byte {
+	h := tmhash.New()
+	_, err := h.Write([]byte(denom))
+	if err != nil {
+		panic(err)
+	}
+	bs := h.Sum(nil)
+
+	key := append(append(HistoryKeyPrefix, bs...), []byte(id)...)
+	return append(key, 0x00)
+}
+
+// GetHistoryEntryKey gets the key of an entry of the history of an NFT
+func GetHistoryEntryKey(denom, id string, sequence uint64) []byte {
+	return append(GetHistoryKey(denom, id), sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// GetCreatorKey gets the key prefix for all the NFTs minted by an address
+func GetCreatorKey(creator sdk.AccAddress) []byte {
+	return append(CreatorsKeyPrefix, creator.Bytes()...)
+}
+
+// GetCreatedKey gets the key of an NFT minted by an address
+func GetCreatedKey(creator sdk.AccAddress, ref NFTRef) []byte {
+	h := tmhash.New()
+	_, err := h.Write([]byte(ref.Denom))
+	if err != nil {
+		panic(err)
+	}
+	bs := h.Sum(nil)
+
+	return append(append(GetCreatorKey(creator), bs...), []byte(ref.ID)...)
+}
+
+// GetHolderKey gets the key prefix for all the NFTs ever held by an address
+func GetHolderKey(holder sdk.AccAddress) []byte {
+	return append(HoldersKeyPrefix, holder.Bytes()...)
+}
+
+// GetHeldKey gets the key of an NFT held by an address
+func GetHeldKey(holder sdk.AccAddress, ref NFTRef) []byte {
+	h := tmhash.New()
+	_, err := h.Write([]byte(ref.Denom))
+	if err != nil {
+		panic(err)
+	}
+	bs := h.Sum(nil)
+
+	return append(append(GetHolderKey(holder), bs...), []byte(ref.ID)...)
+}
+
+// SplitHeldKey gets the holder address from the key of a held NFT
+func SplitHeldKey(key []byte) sdk.AccAddress {
+	if len(key) < 1+sdk.AddrLen+tmhash.Size {
+		panic(fmt.Sprintf("unexpected key length %d", len(key)))
+	}
+	return sdk.AccAddress(key[1 : sdk.AddrLen+1])
+}
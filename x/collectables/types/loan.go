@@ -0,0 +1,113 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+)
+
+// EscrowAddress is the module account holding the NFTs locked by the module, e.g. loan collateral
+var EscrowAddress = supply.NewModuleAddress(ModuleName)
+
+// MaxLoanDuration is the maximum number of blocks a loan can run for, about two years of 6 second blocks.
+// It keeps the due height of a funded loan far from overflowing.
+const MaxLoanDuration int64 = 10000000
+
+// Loan is a peer-to-peer loan of coins collateralized by an NFT held in escrow.
+// A loan without a lender is a request waiting to be funded.
+type Loan struct {
+	ID        uint64         `json:"id" yaml:"id"`
+	Borrower  sdk.AccAddress `json:"borrower" yaml:"borrower"`
+	Lender    sdk.AccAddress `json:"lender,omitempty" yaml:"lender"`
+	Denom     string         `json:"denom" yaml:"denom"`           // collection of the collateral
+	NFTID     string         `json:"nft_id" yaml:"nft_id"`         // ID of the collateral
+	Principal sdk.Coins      `json:"principal" yaml:"principal"`   // lent to the borrower when the loan is funded
+	Interest  sdk.Coins      `json:"interest" yaml:"interest"`     // paid to the lender on top of the principal
+	Duration  int64          `json:"duration" yaml:"duration"`     // number of blocks between funding and the due height
+	DueHeight int64          `json:"due_height" yaml:"due_height"` // block height the loan defaults at, 0 until funded
+}
+
+// NewLoan creates a new loan request
+func NewLoan(id uint64, borrower sdk.AccAddress, denom, nftID string, principal, interest sdk.Coins, duration int64) Loan {
+	return Loan{
+		ID:        id,
+		Borrower:  borrower,
+		Denom:     strings.TrimSpace(denom),
+		NFTID:     strings.TrimSpace(nftID),
+		Principal: principal,
+		Interest:  interest,
+		Duration:  duration,
+	}
+}
+
+// IsFunded returns true once a lender funded the loan
+func (loan Loan) IsFunded() bool {
+	return !loan.Lender.Empty()
+}
+
+// Repayment returns the amount the borrower owes the lender
+func (loan Loan) Repayment() sdk.Coins {
+	return loan.Principal.Add(loan.Interest...)
+}
+
+// Validate performs a basic validation of the loan terms
+func (loan Loan) Validate() error {
+	if loan.Borrower.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid borrower address")
+	}
+	if strings.TrimSpace(loan.Denom) == "" || strings.TrimSpace(loan.NFTID) == "" {
+		return sdkerrors.Wrap(ErrInvalidLoan, "loan needs an NFT as collateral")
+	}
+	if !loan.Principal.IsValid() || loan.Principal.IsZero() {
+		return sdkerrors.Wrap(ErrInvalidLoan, fmt.Sprintf("invalid principal %s", loan.Principal))
+	}
+	if !loan.Interest.IsValid() {
+		return sdkerrors.Wrap(ErrInvalidLoan, fmt.Sprintf("invalid interest %s", loan.Interest))
+	}
+	if loan.Duration <= 0 || loan.Duration > MaxLoanDuration {
+		return sdkerrors.Wrap(ErrInvalidLoan, fmt.Sprintf("duration must be between 1 and %d blocks", MaxLoanDuration))
+	}
+	if loan.IsFunded() != (loan.DueHeight > 0) {
+		return sdkerrors.Wrap(ErrInvalidLoan, "a loan has a due height if and only if it is funded")
+	}
+	return nil
+}
+
+func (loan Loan) String() string {
+	return fmt.Sprintf(`ID:         %d
+Borrower:   %s
+Lender:     %s
+Collateral: %s/%s
+Principal:  %s
+Interest:   %s
+Duration:   %d
+DueHeight:  %d`,
+		loan.ID,
+		loan.Borrower,
+		loan.Lender,
+		loan.Denom,
+		loan.NFTID,
+		loan.Principal,
+		loan.Interest,
+		loan.Duration,
+		loan.DueHeight,
+	)
+}
+
+// Loans is a list of loans
+type Loans []Loan
+
+func (loans Loans) String() string {
+	if len(loans) == 0 {
+		return ""
+	}
+
+	out := ""
+	for _, loan := range loans {
+		out += fmt.Sprintf("%v\n", loan.String())
+	}
+	return out[:len(out)-1]
+}
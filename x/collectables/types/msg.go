@@ -68,23 +68,29 @@ func (msg MsgSendNFT) GetSigners() []sdk.AccAddress {
 // MsgEditNFTMetadata
 /* --------------------------------------------------------------------------- */
 
-// MsgEditNFTMetadata edits an NFT's metadata
+// MsgEditNFTMetadata edits an NFT's metadata, replacing all of its metadata fields
 type MsgEditNFTMetadata struct {
-	Sender sdk.AccAddress `json:"sender" yaml:"sender"`
-	ID     string         `json:"id" yaml:"id"`
-	Denom  string         `json:"denom" yaml:"denom"`
-	Name   string         `json:"name" yaml:"name"`
+	Sender      sdk.AccAddress `json:"sender" yaml:"sender"`
+	ID          string         `json:"id" yaml:"id"`
+	Denom       string         `json:"denom" yaml:"denom"`
+	Name        string         `json:"name" yaml:"name"`
+	Description string         `json:"description" yaml:"description"`
+	TokenURI    string         `json:"token_uri" yaml:"token_uri"`
+	Traits      Traits         `json:"traits" yaml:"traits"`
 }
 
 // NewMsgEditNFTMetadata is a constructor function for MsgSetName
 func NewMsgEditNFTMetadata(sender sdk.AccAddress, id,
-	denom, name string,
+	denom, name, description, tokenURI string, traits Traits,
 ) MsgEditNFTMetadata {
 	return MsgEditNFTMetadata{
-		Sender: sender,
-		ID:     strings.TrimSpace(id),
-		Denom:  strings.TrimSpace(denom),
-		Name:   strings.TrimSpace(name),
+		Sender:      sender,
+		ID:          strings.TrimSpace(id),
+		Denom:       strings.TrimSpace(denom),
+		Name:        strings.TrimSpace(name),
+		Description: strings.TrimSpace(description),
+		TokenURI:    strings.TrimSpace(tokenURI),
+		Traits:      NewTraits(traits...),
 	}
 }
 
@@ -105,7 +111,7 @@ func (msg MsgEditNFTMetadata) ValidateBasic() error {
 	if strings.TrimSpace(msg.Denom) == "" {
 		return ErrInvalidNFT
 	}
-	return nil
+	return ValidateMetadata(msg.Description, msg.TokenURI, msg.Traits)
 }
 
 // GetSignBytes Implements Msg.
@@ -133,19 +139,22 @@ type MsgMintNFT struct {
 	Proof     string         `json:"proof" yaml:"proof"`
 	Name      string         `json:"name" yaml:"name"`
 	Price     sdk.Coins      `json:"price" yaml:"price"`
+	// TransferPolicy of the NFT, the collection policy applies if it is more restrictive
+	TransferPolicy TransferPolicy `json:"transfer_policy,omitempty" yaml:"transfer_policy"`
 }
 
 // NewMsgMintNFT is a constructor function for MsgMintNFT
-func NewMsgMintNFT(sender, recipient sdk.AccAddress, id, denom, hash, proof, name string, price sdk.Coins) MsgMintNFT {
+func NewMsgMintNFT(sender, recipient sdk.AccAddress, id, denom, hash, proof, name string, price sdk.Coins, policy TransferPolicy) MsgMintNFT {
 	return MsgMintNFT{
-		Sender:    sender,
-		Recipient: recipient,
-		ID:        strings.TrimSpace(id),
-		Denom:     strings.TrimSpace(denom),
-		Hash:      strings.TrimSpace(hash),
-		Proof:     strings.TrimSpace(proof),
-		Name:      strings.TrimSpace(name),
-		Price:     price,
+		Sender:         sender,
+		Recipient:      recipient,
+		ID:             strings.TrimSpace(id),
+		Denom:          strings.TrimSpace(denom),
+		Hash:           strings.TrimSpace(hash),
+		Proof:          strings.TrimSpace(proof),
+		Name:           strings.TrimSpace(name),
+		Price:          price,
+		TransferPolicy: policy,
 	}
 }
 
@@ -160,6 +169,9 @@ func (msg MsgMintNFT) ValidateBasic() error {
 	if strings.TrimSpace(msg.Denom) == "" {
 		return ErrInvalidNFT
 	}
+	if msg.Denom == BundleDenom {
+		return sdkerrors.Wrap(ErrInvalidCollection, "collection "+BundleDenom+" is reserved for bundles")
+	}
 	if strings.TrimSpace(msg.ID) == "" {
 		return ErrInvalidNFT
 	}
@@ -169,7 +181,13 @@ func (msg MsgMintNFT) ValidateBasic() error {
 	if msg.Recipient.Empty() {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid recipient address")
 	}
-	return nil
+	if err := ValidateProof(msg.Proof); err != nil {
+		return err
+	}
+	if err := ValidateHash(msg.Hash); err != nil {
+		return err
+	}
+	return msg.TransferPolicy.Validate()
 }
 
 // GetSignBytes Implements Msg.
@@ -392,6 +410,9 @@ func (msg MsgChallengeNFT) ValidateBasic() error {
 	if strings.TrimSpace(msg.DefiantID) == "" {
 		return ErrInvalidNFT
 	}
+	if msg.ContenderDenom == msg.DefiantDenom && msg.ContenderID == msg.DefiantID {
+		return sdkerrors.Wrap(ErrInvalidNFT, "an NFT can't challenge itself")
+	}
 	return nil
 }
 
@@ -405,3 +426,1087 @@ func (msg MsgChallengeNFT) GetSignBytes() []byte {
 func (msg MsgChallengeNFT) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Sender}
 }
+
+/* --------------------------------------------------------------------------- */
+// MsgCreateCollection
+/* --------------------------------------------------------------------------- */
+
+// MsgCreateCollection defines a CreateCollection message
+type MsgCreateCollection struct {
+	Sender sdk.AccAddress `json:"sender" yaml:"sender"`
+	Denom  string         `json:"denom" yaml:"denom"`
+	Schema TraitSchema    `json:"schema" yaml:"schema"`
+	// Commitment is the blake3 hash of the final metadata for a delayed reveal, empty otherwise
+	Commitment string `json:"commitment,omitempty" yaml:"commitment"`
+	// TransferPolicy is the minimum transfer restriction of every NFT of the collection
+	TransferPolicy TransferPolicy `json:"transfer_policy,omitempty" yaml:"transfer_policy"`
+	// Issuer is the optional compliance issuer, it only holds the declared capabilities
+	Issuer             sdk.AccAddress     `json:"issuer,omitempty" yaml:"issuer"`
+	IssuerCapabilities IssuerCapabilities `json:"issuer_capabilities,omitempty" yaml:"issuer_capabilities"`
+}
+
+// NewMsgCreateCollection is a constructor function for MsgCreateCollection
+func NewMsgCreateCollection(sender sdk.AccAddress, denom string, schema TraitSchema, commitment string, policy TransferPolicy,
+	issuer sdk.AccAddress, capabilities IssuerCapabilities) MsgCreateCollection {
+	return MsgCreateCollection{
+		Sender:             sender,
+		Denom:              strings.TrimSpace(denom),
+		Schema:             schema,
+		Commitment:         strings.TrimSpace(commitment),
+		TransferPolicy:     policy,
+		Issuer:             issuer,
+		IssuerCapabilities: capabilities,
+	}
+}
+
+// Route Implements Msg
+func (msg MsgCreateCollection) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgCreateCollection) Type() string { return "create_collection" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgCreateCollection) ValidateBasic() error {
+	if strings.TrimSpace(msg.Denom) == "" {
+		return ErrInvalidCollection
+	}
+	if msg.Denom == BundleDenom {
+		return sdkerrors.Wrap(ErrInvalidCollection, "collection "+BundleDenom+" is reserved for bundles")
+	}
+	if msg.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+	if msg.Commitment != "" {
+		if err := ValidateCommitment(msg.Commitment); err != nil {
+			return err
+		}
+	}
+	if err := msg.TransferPolicy.Validate(); err != nil {
+		return err
+	}
+	if msg.Issuer.Empty() && len(msg.IssuerCapabilities) > 0 {
+		return sdkerrors.Wrap(ErrInvalidCapability, "issuer capabilities need an issuer")
+	}
+	if err := msg.IssuerCapabilities.Validate(); err != nil {
+		return err
+	}
+	return msg.Schema.Validate()
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgCreateCollection) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgCreateCollection) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+/* --------------------------------------------------------------------------- */
+// MsgFreezeMetadata
+/* --------------------------------------------------------------------------- */
+
+// MsgFreezeMetadata freezes the metadata of a single NFT or, without an ID, of a whole collection
+type MsgFreezeMetadata struct {
+	Sender sdk.AccAddress `json:"sender" yaml:"sender"`
+	Denom  string         `json:"denom" yaml:"denom"`
+	ID     string         `json:"id,omitempty" yaml:"id"`
+}
+
+// NewMsgFreezeMetadata is a constructor function for MsgFreezeMetadata
+func NewMsgFreezeMetadata(sender sdk.AccAddress, denom, id string) MsgFreezeMetadata {
+	return MsgFreezeMetadata{
+		Sender: sender,
+		Denom:  strings.TrimSpace(denom),
+		ID:     strings.TrimSpace(id),
+	}
+}
+
+// Route Implements Msg
+func (msg MsgFreezeMetadata) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgFreezeMetadata) Type() string { return "freeze_metadata" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgFreezeMetadata) ValidateBasic() error {
+	if strings.TrimSpace(msg.Denom) == "" {
+		return ErrInvalidCollection
+	}
+	if msg.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgFreezeMetadata) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgFreezeMetadata) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+/* --------------------------------------------------------------------------- */
+// MsgRevealCollection
+/* --------------------------------------------------------------------------- */
+
+// MsgRevealCollection publishes the final metadata of a delayed reveal collection
+type MsgRevealCollection struct {
+	Sender   sdk.AccAddress `json:"sender" yaml:"sender"`
+	Denom    string         `json:"denom" yaml:"denom"`
+	Metadata RevealMetadata `json:"metadata" yaml:"metadata"`
+}
+
+// NewMsgRevealCollection is a constructor function for MsgRevealCollection
+func NewMsgRevealCollection(sender sdk.AccAddress, denom string, metadata RevealMetadata) MsgRevealCollection {
+	return MsgRevealCollection{
+		Sender:   sender,
+		Denom:    strings.TrimSpace(denom),
+		Metadata: metadata,
+	}
+}
+
+// Route Implements Msg
+func (msg MsgRevealCollection) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgRevealCollection) Type() string { return "reveal_collection" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgRevealCollection) ValidateBasic() error {
+	if strings.TrimSpace(msg.Denom) == "" {
+		return ErrInvalidCollection
+	}
+	if msg.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+	return msg.Metadata.Validate()
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgRevealCollection) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgRevealCollection) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+/* --------------------------------------------------------------------------- */
+// MsgFreezeNFT
+/* --------------------------------------------------------------------------- */
+
+// MsgFreezeNFT stops an NFT from changing hands on behalf of the collection issuer
+type MsgFreezeNFT struct {
+	Sender sdk.AccAddress `json:"sender" yaml:"sender"`
+	Denom  string         `json:"denom" yaml:"denom"`
+	ID     string         `json:"id" yaml:"id"`
+}
+
+// NewMsgFreezeNFT is a constructor function for MsgFreezeNFT
+func NewMsgFreezeNFT(sender sdk.AccAddress, denom, id string) MsgFreezeNFT {
+	return MsgFreezeNFT{
+		Sender: sender,
+		Denom:  strings.TrimSpace(denom),
+		ID:     strings.TrimSpace(id),
+	}
+}
+
+// Route Implements Msg
+func (msg MsgFreezeNFT) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgFreezeNFT) Type() string { return "freeze_nft" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgFreezeNFT) ValidateBasic() error {
+	if strings.TrimSpace(msg.Denom) == "" {
+		return ErrInvalidCollection
+	}
+	if strings.TrimSpace(msg.ID) == "" {
+		return ErrInvalidNFT
+	}
+	if msg.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgFreezeNFT) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgFreezeNFT) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+/* --------------------------------------------------------------------------- */
+// MsgUnfreezeNFT
+/* --------------------------------------------------------------------------- */
+
+// MsgUnfreezeNFT lifts a freeze of the collection issuer
+type MsgUnfreezeNFT struct {
+	Sender sdk.AccAddress `json:"sender" yaml:"sender"`
+	Denom  string         `json:"denom" yaml:"denom"`
+	ID     string         `json:"id" yaml:"id"`
+}
+
+// NewMsgUnfreezeNFT is a constructor function for MsgUnfreezeNFT
+func NewMsgUnfreezeNFT(sender sdk.AccAddress, denom, id string) MsgUnfreezeNFT {
+	return MsgUnfreezeNFT{
+		Sender: sender,
+		Denom:  strings.TrimSpace(denom),
+		ID:     strings.TrimSpace(id),
+	}
+}
+
+// Route Implements Msg
+func (msg MsgUnfreezeNFT) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgUnfreezeNFT) Type() string { return "unfreeze_nft" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgUnfreezeNFT) ValidateBasic() error {
+	if strings.TrimSpace(msg.Denom) == "" {
+		return ErrInvalidCollection
+	}
+	if strings.TrimSpace(msg.ID) == "" {
+		return ErrInvalidNFT
+	}
+	if msg.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgUnfreezeNFT) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgUnfreezeNFT) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+/* --------------------------------------------------------------------------- */
+// MsgClawbackNFT
+/* --------------------------------------------------------------------------- */
+
+// MsgClawbackNFT moves an NFT to a recipient chosen by the collection issuer
+type MsgClawbackNFT struct {
+	Sender    sdk.AccAddress `json:"sender" yaml:"sender"`
+	Denom     string         `json:"denom" yaml:"denom"`
+	ID        string         `json:"id" yaml:"id"`
+	Recipient sdk.AccAddress `json:"recipient" yaml:"recipient"`
+}
+
+// NewMsgClawbackNFT is a constructor function for MsgClawbackNFT
+func NewMsgClawbackNFT(sender sdk.AccAddress, denom, id string, recipient sdk.AccAddress) MsgClawbackNFT {
+	return MsgClawbackNFT{
+		Sender:    sender,
+		Denom:     strings.TrimSpace(denom),
+		ID:        strings.TrimSpace(id),
+		Recipient: recipient,
+	}
+}
+
+// Route Implements Msg
+func (msg MsgClawbackNFT) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgClawbackNFT) Type() string { return "clawback_nft" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgClawbackNFT) ValidateBasic() error {
+	if strings.TrimSpace(msg.Denom) == "" {
+		return ErrInvalidCollection
+	}
+	if strings.TrimSpace(msg.ID) == "" {
+		return ErrInvalidNFT
+	}
+	if msg.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+	if msg.Recipient.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid recipient address")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgClawbackNFT) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgClawbackNFT) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+/* --------------------------------------------------------------------------- */
+// MsgRentNFT
+/* --------------------------------------------------------------------------- */
+
+// MsgRentNFT grants the usage rights of an NFT to a renter for a number of blocks while
+// the owner keeps the ownership. A rental with a fee has to be signed by the renter too.
+type MsgRentNFT struct {
+	Sender   sdk.AccAddress `json:"sender" yaml:"sender"`
+	Denom    string         `json:"denom" yaml:"denom"`
+	ID       string         `json:"id" yaml:"id"`
+	Renter   sdk.AccAddress `json:"renter" yaml:"renter"`
+	Duration int64          `json:"duration" yaml:"duration"` // number of blocks the rental lasts
+	Fee      sdk.Coins      `json:"fee" yaml:"fee"`           // paid by the renter to the owner
+}
+
+// NewMsgRentNFT is a constructor function for MsgRentNFT
+func NewMsgRentNFT(sender sdk.AccAddress, denom, id string, renter sdk.AccAddress, duration int64, fee sdk.Coins) MsgRentNFT {
+	return MsgRentNFT{
+		Sender:   sender,
+		Denom:    strings.TrimSpace(denom),
+		ID:       strings.TrimSpace(id),
+		Renter:   renter,
+		Duration: duration,
+		Fee:      fee,
+	}
+}
+
+// Route Implements Msg
+func (msg MsgRentNFT) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgRentNFT) Type() string { return "rent_nft" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgRentNFT) ValidateBasic() error {
+	if strings.TrimSpace(msg.Denom) == "" {
+		return ErrInvalidCollection
+	}
+	if strings.TrimSpace(msg.ID) == "" {
+		return ErrInvalidNFT
+	}
+	if msg.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+	if msg.Renter.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid renter address")
+	}
+	if msg.Renter.Equals(msg.Sender) {
+		return sdkerrors.Wrap(ErrInvalidRental, "owner can't rent to itself")
+	}
+	if msg.Duration <= 0 {
+		return sdkerrors.Wrap(ErrInvalidRental, "duration must be positive")
+	}
+	if !msg.Fee.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.Fee.String())
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgRentNFT) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgRentNFT) GetSigners() []sdk.AccAddress {
+	if msg.Fee.IsZero() {
+		return []sdk.AccAddress{msg.Sender}
+	}
+	return []sdk.AccAddress{msg.Sender, msg.Renter}
+}
+
+/* --------------------------------------------------------------------------- */
+// MsgRequestLoan
+/* --------------------------------------------------------------------------- */
+
+// MsgRequestLoan locks an NFT of the sender in escrow as the collateral of a loan request
+type MsgRequestLoan struct {
+	Sender    sdk.AccAddress `json:"sender" yaml:"sender"`
+	Denom     string         `json:"denom" yaml:"denom"`
+	ID        string         `json:"id" yaml:"id"`
+	Principal sdk.Coins      `json:"principal" yaml:"principal"`
+	Interest  sdk.Coins      `json:"interest" yaml:"interest"`
+	Duration  int64          `json:"duration" yaml:"duration"` // number of blocks between funding and the due height
+}
+
+// NewMsgRequestLoan is a constructor function for MsgRequestLoan
+func NewMsgRequestLoan(sender sdk.AccAddress, denom, id string, principal, interest sdk.Coins, duration int64) MsgRequestLoan {
+	return MsgRequestLoan{
+		Sender:    sender,
+		Denom:     strings.TrimSpace(denom),
+		ID:        strings.TrimSpace(id),
+		Principal: principal,
+		Interest:  interest,
+		Duration:  duration,
+	}
+}
+
+// Route Implements Msg
+func (msg MsgRequestLoan) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgRequestLoan) Type() string { return "request_loan" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgRequestLoan) ValidateBasic() error {
+	return NewLoan(0, msg.Sender, msg.Denom, msg.ID, msg.Principal, msg.Interest, msg.Duration).Validate()
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgRequestLoan) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgRequestLoan) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+/* --------------------------------------------------------------------------- */
+// MsgCancelLoan
+/* --------------------------------------------------------------------------- */
+
+// MsgCancelLoan closes a loan request that wasn't funded and unlocks the collateral
+type MsgCancelLoan struct {
+	Sender sdk.AccAddress `json:"sender" yaml:"sender"`
+	LoanID uint64         `json:"loan_id" yaml:"loan_id"`
+}
+
+// NewMsgCancelLoan is a constructor function for MsgCancelLoan
+func NewMsgCancelLoan(sender sdk.AccAddress, loanID uint64) MsgCancelLoan {
+	return MsgCancelLoan{
+		Sender: sender,
+		LoanID: loanID,
+	}
+}
+
+// Route Implements Msg
+func (msg MsgCancelLoan) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgCancelLoan) Type() string { return "cancel_loan" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgCancelLoan) ValidateBasic() error {
+	if msg.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+	if msg.LoanID == 0 {
+		return sdkerrors.Wrap(ErrUnknownLoan, "loan IDs start at 1")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgCancelLoan) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgCancelLoan) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+/* --------------------------------------------------------------------------- */
+// MsgFundLoan
+/* --------------------------------------------------------------------------- */
+
+// MsgFundLoan lends the principal of a loan request to its borrower
+type MsgFundLoan struct {
+	Sender sdk.AccAddress `json:"sender" yaml:"sender"`
+	LoanID uint64         `json:"loan_id" yaml:"loan_id"`
+}
+
+// NewMsgFundLoan is a constructor function for MsgFundLoan
+func NewMsgFundLoan(sender sdk.AccAddress, loanID uint64) MsgFundLoan {
+	return MsgFundLoan{
+		Sender: sender,
+		LoanID: loanID,
+	}
+}
+
+// Route Implements Msg
+func (msg MsgFundLoan) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgFundLoan) Type() string { return "fund_loan" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgFundLoan) ValidateBasic() error {
+	if msg.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+	if msg.LoanID == 0 {
+		return sdkerrors.Wrap(ErrUnknownLoan, "loan IDs start at 1")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgFundLoan) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgFundLoan) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+/* --------------------------------------------------------------------------- */
+// MsgRepayLoan
+/* --------------------------------------------------------------------------- */
+
+// MsgRepayLoan pays a loan back to the lender and unlocks the collateral
+type MsgRepayLoan struct {
+	Sender sdk.AccAddress `json:"sender" yaml:"sender"`
+	LoanID uint64         `json:"loan_id" yaml:"loan_id"`
+}
+
+// NewMsgRepayLoan is a constructor function for MsgRepayLoan
+func NewMsgRepayLoan(sender sdk.AccAddress, loanID uint64) MsgRepayLoan {
+	return MsgRepayLoan{
+		Sender: sender,
+		LoanID: loanID,
+	}
+}
+
+// Route Implements Msg
+func (msg MsgRepayLoan) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgRepayLoan) Type() string { return "repay_loan" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgRepayLoan) ValidateBasic() error {
+	if msg.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+	if msg.LoanID == 0 {
+		return sdkerrors.Wrap(ErrUnknownLoan, "loan IDs start at 1")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgRepayLoan) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgRepayLoan) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+/* --------------------------------------------------------------------------- */
+// MsgFractionalize
+/* --------------------------------------------------------------------------- */
+
+// MsgFractionalize locks an NFT of the sender in a vault and mints fungible shares of it to the sender
+type MsgFractionalize struct {
+	Sender       sdk.AccAddress `json:"sender" yaml:"sender"`
+	Denom        string         `json:"denom" yaml:"denom"`
+	ID           string         `json:"id" yaml:"id"`
+	Shares       sdk.Int        `json:"shares" yaml:"shares"`
+	ReservePrice sdk.Coins      `json:"reserve_price" yaml:"reserve_price"` // optional, the NFT can't be bought out without it
+}
+
+// NewMsgFractionalize is a constructor function for MsgFractionalize
+func NewMsgFractionalize(sender sdk.AccAddress, denom, id string, shares sdk.Int, reservePrice sdk.Coins) MsgFractionalize {
+	return MsgFractionalize{
+		Sender:       sender,
+		Denom:        strings.TrimSpace(denom),
+		ID:           strings.TrimSpace(id),
+		Shares:       shares,
+		ReservePrice: reservePrice,
+	}
+}
+
+// Route Implements Msg
+func (msg MsgFractionalize) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgFractionalize) Type() string { return "fractionalize" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgFractionalize) ValidateBasic() error {
+	return NewVault(msg.Denom, msg.ID, msg.Sender, msg.Shares, msg.ReservePrice).Validate()
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgFractionalize) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgFractionalize) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+/* --------------------------------------------------------------------------- */
+// MsgRedeem
+/* --------------------------------------------------------------------------- */
+
+// MsgRedeem burns the vault shares of the sender, for the NFT if the sender holds all of them or for a part of the buyout proceeds
+type MsgRedeem struct {
+	Sender sdk.AccAddress `json:"sender" yaml:"sender"`
+	Denom  string         `json:"denom" yaml:"denom"`
+	ID     string         `json:"id" yaml:"id"`
+}
+
+// NewMsgRedeem is a constructor function for MsgRedeem
+func NewMsgRedeem(sender sdk.AccAddress, denom, id string) MsgRedeem {
+	return MsgRedeem{
+		Sender: sender,
+		Denom:  strings.TrimSpace(denom),
+		ID:     strings.TrimSpace(id),
+	}
+}
+
+// Route Implements Msg
+func (msg MsgRedeem) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgRedeem) Type() string { return "redeem" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgRedeem) ValidateBasic() error {
+	if strings.TrimSpace(msg.Denom) == "" {
+		return ErrInvalidCollection
+	}
+	if strings.TrimSpace(msg.ID) == "" {
+		return ErrInvalidNFT
+	}
+	if msg.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgRedeem) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgRedeem) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+/* --------------------------------------------------------------------------- */
+// MsgBuyout
+/* --------------------------------------------------------------------------- */
+
+// MsgBuyout buys a vaulted NFT at its reserve price for the shareholders
+type MsgBuyout struct {
+	Sender sdk.AccAddress `json:"sender" yaml:"sender"`
+	Denom  string         `json:"denom" yaml:"denom"`
+	ID     string         `json:"id" yaml:"id"`
+}
+
+// NewMsgBuyout is a constructor function for MsgBuyout
+func NewMsgBuyout(sender sdk.AccAddress, denom, id string) MsgBuyout {
+	return MsgBuyout{
+		Sender: sender,
+		Denom:  strings.TrimSpace(denom),
+		ID:     strings.TrimSpace(id),
+	}
+}
+
+// Route Implements Msg
+func (msg MsgBuyout) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgBuyout) Type() string { return "buyout" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgBuyout) ValidateBasic() error {
+	if strings.TrimSpace(msg.Denom) == "" {
+		return ErrInvalidCollection
+	}
+	if strings.TrimSpace(msg.ID) == "" {
+		return ErrInvalidNFT
+	}
+	if msg.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgBuyout) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgBuyout) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+/* --------------------------------------------------------------------------- */
+// MsgWrapNFTs
+/* --------------------------------------------------------------------------- */
+
+// MsgWrapNFTs locks NFTs of the sender in escrow and mints a bundle NFT representing them
+type MsgWrapNFTs struct {
+	Sender     sdk.AccAddress `json:"sender" yaml:"sender"`
+	Components []NFTRef       `json:"components" yaml:"components"`
+}
+
+// NewMsgWrapNFTs is a constructor function for MsgWrapNFTs
+func NewMsgWrapNFTs(sender sdk.AccAddress, components []NFTRef) MsgWrapNFTs {
+	return MsgWrapNFTs{
+		Sender:     sender,
+		Components: trimNFTRefs(components),
+	}
+}
+
+// Route Implements Msg
+func (msg MsgWrapNFTs) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgWrapNFTs) Type() string { return "wrap_nfts" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgWrapNFTs) ValidateBasic() error {
+	if msg.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+	return ValidateBundleComponents(NFTRef{}, msg.Components)
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgWrapNFTs) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgWrapNFTs) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+/* --------------------------------------------------------------------------- */
+// MsgUnwrapBundle
+/* --------------------------------------------------------------------------- */
+
+// MsgUnwrapBundle burns a bundle NFT of the sender and gives the NFTs it wraps back to the sender
+type MsgUnwrapBundle struct {
+	Sender sdk.AccAddress `json:"sender" yaml:"sender"`
+	ID     string         `json:"id" yaml:"id"`
+}
+
+// NewMsgUnwrapBundle is a constructor function for MsgUnwrapBundle
+func NewMsgUnwrapBundle(sender sdk.AccAddress, id string) MsgUnwrapBundle {
+	return MsgUnwrapBundle{
+		Sender: sender,
+		ID:     strings.TrimSpace(id),
+	}
+}
+
+// Route Implements Msg
+func (msg MsgUnwrapBundle) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgUnwrapBundle) Type() string { return "unwrap_bundle" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgUnwrapBundle) ValidateBasic() error {
+	if strings.TrimSpace(msg.ID) == "" {
+		return ErrInvalidNFT
+	}
+	if msg.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgUnwrapBundle) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgUnwrapBundle) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+/* --------------------------------------------------------------------------- */
+// MsgProposeSwap
+/* --------------------------------------------------------------------------- */
+
+// MsgProposeSwap locks NFTs and coins of the sender in escrow and offers them to a counterparty
+// in exchange for NFTs and coins of the counterparty
+type MsgProposeSwap struct {
+	Sender         sdk.AccAddress `json:"sender" yaml:"sender"`
+	Counterparty   sdk.AccAddress `json:"counterparty" yaml:"counterparty"`
+	OfferedNFTs    []NFTRef       `json:"offered_nfts" yaml:"offered_nfts"`
+	OfferedCoins   sdk.Coins      `json:"offered_coins" yaml:"offered_coins"`
+	RequestedNFTs  []NFTRef       `json:"requested_nfts" yaml:"requested_nfts"`
+	RequestedCoins sdk.Coins      `json:"requested_coins" yaml:"requested_coins"`
+}
+
+// NewMsgProposeSwap is a constructor function for MsgProposeSwap
+func NewMsgProposeSwap(sender, counterparty sdk.AccAddress, offeredNFTs []NFTRef, offeredCoins sdk.Coins,
+	requestedNFTs []NFTRef, requestedCoins sdk.Coins) MsgProposeSwap {
+	return MsgProposeSwap{
+		Sender:         sender,
+		Counterparty:   counterparty,
+		OfferedNFTs:    trimNFTRefs(offeredNFTs),
+		OfferedCoins:   offeredCoins,
+		RequestedNFTs:  trimNFTRefs(requestedNFTs),
+		RequestedCoins: requestedCoins,
+	}
+}
+
+// Route Implements Msg
+func (msg MsgProposeSwap) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgProposeSwap) Type() string { return "propose_swap" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgProposeSwap) ValidateBasic() error {
+	return NewSwap(0, msg.Sender, msg.Counterparty, msg.OfferedNFTs, msg.OfferedCoins, msg.RequestedNFTs, msg.RequestedCoins).Validate()
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgProposeSwap) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgProposeSwap) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+/* --------------------------------------------------------------------------- */
+// MsgAcceptSwap
+/* --------------------------------------------------------------------------- */
+
+// MsgAcceptSwap settles a swap addressed to the sender
+type MsgAcceptSwap struct {
+	Sender sdk.AccAddress `json:"sender" yaml:"sender"`
+	SwapID uint64         `json:"swap_id" yaml:"swap_id"`
+}
+
+// NewMsgAcceptSwap is a constructor function for MsgAcceptSwap
+func NewMsgAcceptSwap(sender sdk.AccAddress, swapID uint64) MsgAcceptSwap {
+	return MsgAcceptSwap{
+		Sender: sender,
+		SwapID: swapID,
+	}
+}
+
+// Route Implements Msg
+func (msg MsgAcceptSwap) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgAcceptSwap) Type() string { return "accept_swap" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgAcceptSwap) ValidateBasic() error {
+	if msg.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+	if msg.SwapID == 0 {
+		return sdkerrors.Wrap(ErrUnknownSwap, "swap IDs start at 1")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgAcceptSwap) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgAcceptSwap) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+/* --------------------------------------------------------------------------- */
+// MsgCancelSwap
+/* --------------------------------------------------------------------------- */
+
+// MsgCancelSwap closes a swap proposed by the sender and unlocks what it offered
+type MsgCancelSwap struct {
+	Sender sdk.AccAddress `json:"sender" yaml:"sender"`
+	SwapID uint64         `json:"swap_id" yaml:"swap_id"`
+}
+
+// NewMsgCancelSwap is a constructor function for MsgCancelSwap
+func NewMsgCancelSwap(sender sdk.AccAddress, swapID uint64) MsgCancelSwap {
+	return MsgCancelSwap{
+		Sender: sender,
+		SwapID: swapID,
+	}
+}
+
+// Route Implements Msg
+func (msg MsgCancelSwap) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgCancelSwap) Type() string { return "cancel_swap" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgCancelSwap) ValidateBasic() error {
+	if msg.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+	if msg.SwapID == 0 {
+		return sdkerrors.Wrap(ErrUnknownSwap, "swap IDs start at 1")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgCancelSwap) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgCancelSwap) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+/* --------------------------------------------------------------------------- */
+// MsgEquip
+/* --------------------------------------------------------------------------- */
+
+// MsgEquip equips an NFT of the sender onto a parent NFT held by the sender
+type MsgEquip struct {
+	Sender      sdk.AccAddress `json:"sender" yaml:"sender"`
+	Denom       string         `json:"denom" yaml:"denom"`
+	ID          string         `json:"id" yaml:"id"`
+	ParentDenom string         `json:"parent_denom" yaml:"parent_denom"`
+	ParentID    string         `json:"parent_id" yaml:"parent_id"`
+}
+
+// NewMsgEquip is a constructor function for MsgEquip
+func NewMsgEquip(sender sdk.AccAddress, denom, id, parentDenom, parentID string) MsgEquip {
+	return MsgEquip{
+		Sender:      sender,
+		Denom:       strings.TrimSpace(denom),
+		ID:          strings.TrimSpace(id),
+		ParentDenom: strings.TrimSpace(parentDenom),
+		ParentID:    strings.TrimSpace(parentID),
+	}
+}
+
+// Route Implements Msg
+func (msg MsgEquip) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgEquip) Type() string { return "equip" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgEquip) ValidateBasic() error {
+	if strings.TrimSpace(msg.Denom) == "" || strings.TrimSpace(msg.ParentDenom) == "" {
+		return ErrInvalidCollection
+	}
+	if strings.TrimSpace(msg.ID) == "" || strings.TrimSpace(msg.ParentID) == "" {
+		return ErrInvalidNFT
+	}
+	if msg.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+	return ValidateEquip(NewNFTRef(msg.Denom, msg.ID), NewNFTRef(msg.ParentDenom, msg.ParentID))
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgEquip) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgEquip) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+/* --------------------------------------------------------------------------- */
+// MsgUnequip
+/* --------------------------------------------------------------------------- */
+
+// MsgUnequip detaches an equipped NFT from its parent and gives it back to the sender
+type MsgUnequip struct {
+	Sender sdk.AccAddress `json:"sender" yaml:"sender"`
+	Denom  string         `json:"denom" yaml:"denom"`
+	ID     string         `json:"id" yaml:"id"`
+}
+
+// NewMsgUnequip is a constructor function for MsgUnequip
+func NewMsgUnequip(sender sdk.AccAddress, denom, id string) MsgUnequip {
+	return MsgUnequip{
+		Sender: sender,
+		Denom:  strings.TrimSpace(denom),
+		ID:     strings.TrimSpace(id),
+	}
+}
+
+// Route Implements Msg
+func (msg MsgUnequip) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgUnequip) Type() string { return "unequip" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgUnequip) ValidateBasic() error {
+	if strings.TrimSpace(msg.Denom) == "" {
+		return ErrInvalidCollection
+	}
+	if strings.TrimSpace(msg.ID) == "" {
+		return ErrInvalidNFT
+	}
+	if msg.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgUnequip) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgUnequip) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// trimNFTRefs trims the denoms and IDs of NFT references
+func trimNFTRefs(refs []NFTRef) []NFTRef {
+	trimmed := make([]NFTRef, len(refs))
+	for i, ref := range refs {
+		trimmed[i] = NewNFTRef(strings.TrimSpace(ref.Denom), strings.TrimSpace(ref.ID))
+	}
+	return trimmed
+}
@@ -22,6 +22,22 @@ type BaseNFT struct {
 	Wins   uint      `json:"wins" yaml:"wins"`     // Challenge Wins
 	Losses uint      `json:"losses" yaml:"losses"` // Challenge Losses
 	Price  sdk.Coins `json:"price" yaml:"price"`   // The price set for the token
+	//metadata
+	Description string `json:"description" yaml:"description"` // The user given description of the NFT Token
+	TokenURI    string `json:"token_uri" yaml:"token_uri"`     // URI for supplemental off-chain metadata
+	Traits      Traits `json:"traits" yaml:"traits"`           // Typed on-chain attributes of the NFT Token
+	Stats       Stats  `json:"stats" yaml:"stats"`             // Game stats derived from the hash at mint time
+	Frozen      bool   `json:"frozen" yaml:"frozen"`           // Metadata can't be edited anymore once frozen
+	//transfer restrictions
+	TransferPolicy TransferPolicy `json:"transfer_policy" yaml:"transfer_policy"` // Whether the NFT Token can change hands
+	IssuerFrozen   bool           `json:"issuer_frozen" yaml:"issuer_frozen"`     // Frozen by the collection issuer, can't change hands until unfrozen
+	//rental
+	User        sdk.AccAddress `json:"user,omitempty" yaml:"user"`       // Account renting the usage rights of the NFT Token
+	UserExpires int64          `json:"user_expires" yaml:"user_expires"` // Block height at which the rental ends
+	//composition
+	Parent NFTRef `json:"parent" yaml:"parent"` // NFT the NFT Token is equipped onto, empty if it is owned by an account
+	//provenance
+	Creator sdk.AccAddress `json:"creator,omitempty" yaml:"creator"` // Account that minted the NFT Token, empty for tokens minted before it was recorded
 }
 
 // NewBaseNFT creates a new NFT instance
@@ -67,14 +83,89 @@ func (bnft BaseNFT) GetLosses() uint { return bnft.Losses }
 // GetPrice returns the price for an NFT Token
 func (bnft *BaseNFT) GetPrice() sdk.Coins { return bnft.Price }
 
+// GetDescription returns the description of an NFT Token
+func (bnft BaseNFT) GetDescription() string { return bnft.Description }
+
+// GetTokenURI returns the URI of the off-chain metadata of an NFT Token
+func (bnft BaseNFT) GetTokenURI() string { return bnft.TokenURI }
+
+// GetTraits returns the on-chain attributes of an NFT Token
+func (bnft BaseNFT) GetTraits() Traits { return bnft.Traits }
+
+// GetStats returns the game stats of an NFT Token
+func (bnft BaseNFT) GetStats() Stats { return bnft.Stats }
+
+// SetStats sets the game stats of an NFT Token
+func (bnft *BaseNFT) SetStats(stats Stats) {
+	bnft.Stats = stats
+}
+
+// IsFrozen returns true if the metadata of an NFT Token can't be edited anymore
+func (bnft BaseNFT) IsFrozen() bool { return bnft.Frozen }
+
+// Freeze makes the metadata of an NFT Token immutable
+func (bnft *BaseNFT) Freeze() {
+	bnft.Frozen = true
+}
+
+// GetTransferPolicy returns whether an NFT Token can change hands
+func (bnft BaseNFT) GetTransferPolicy() TransferPolicy { return bnft.TransferPolicy.Normalize() }
+
+// SetTransferPolicy sets whether an NFT Token can change hands
+func (bnft *BaseNFT) SetTransferPolicy(policy TransferPolicy) {
+	bnft.TransferPolicy = policy
+}
+
+// IsIssuerFrozen returns true if the collection issuer froze the NFT Token
+func (bnft BaseNFT) IsIssuerFrozen() bool { return bnft.IssuerFrozen }
+
+// SetIssuerFrozen freezes or unfreezes an NFT Token on behalf of the collection issuer
+func (bnft *BaseNFT) SetIssuerFrozen(frozen bool) {
+	bnft.IssuerFrozen = frozen
+}
+
+// GetUser returns the account renting the NFT Token, empty if it isn't rented
+func (bnft BaseNFT) GetUser() sdk.AccAddress { return bnft.User }
+
+// GetUserExpires returns the block height at which the rental of the NFT Token ends
+func (bnft BaseNFT) GetUserExpires() int64 { return bnft.UserExpires }
+
+// SetUser grants the usage rights of the NFT Token until a block height, an empty user ends the rental
+func (bnft *BaseNFT) SetUser(user sdk.AccAddress, expires int64) {
+	bnft.User = user
+	bnft.UserExpires = expires
+}
+
+// GetParent returns the NFT the NFT Token is equipped onto, empty if it isn't equipped
+func (bnft BaseNFT) GetParent() NFTRef { return bnft.Parent }
+
+// SetParent equips the NFT Token onto a parent NFT, an empty parent unequips it
+func (bnft *BaseNFT) SetParent(parent NFTRef) {
+	bnft.Parent = parent
+}
+
+// GetCreator returns the account that minted the NFT Token
+func (bnft BaseNFT) GetCreator() sdk.AccAddress { return bnft.Creator }
+
+// SetCreator records the account that minted the NFT Token
+func (bnft *BaseNFT) SetCreator(creator sdk.AccAddress) {
+	bnft.Creator = creator
+}
+
 // EditPrice removes an Ask order to an nft.
 func (bnft *BaseNFT) EditPrice(price sdk.Coins) {
 	bnft.Price = price
 }
 
-// EditMetadata edits metadata of an nft
-func (bnft *BaseNFT) EditMetadata(name string) {
+// EditMetadata edits metadata of an nft, the traits are copied and sorted by key for lookups
+func (bnft *BaseNFT) EditMetadata(name, description, tokenURI string, traits Traits) {
 	bnft.Name = name
+	bnft.Description = description
+	bnft.TokenURI = tokenURI
+	bnft.Traits = nil
+	if len(traits) > 0 {
+		bnft.Traits = append(Traits{}, traits...).Sort()
+	}
 }
 
 // IncreaseWins inceases wins of an nft
@@ -82,9 +173,9 @@ func (bnft *BaseNFT) IncreaseWins() {
 	bnft.Wins = bnft.Wins + 1
 }
 
-// IncreaseLosses inceases wins of an nft
+// IncreaseLosses inceases losses of an nft
 func (bnft *BaseNFT) IncreaseLosses() {
-	bnft.Wins = bnft.Losses + 1
+	bnft.Losses = bnft.Losses + 1
 }
 
 func (bnft BaseNFT) String() string {
@@ -95,7 +186,18 @@ Proof:		%s
 Name:		%s
 Wins:       %v
 Losses:     %v
-Price:      %v`,
+Price:      %v
+Description: %s
+TokenURI:   %s
+Traits:     %s
+Stats:      %s
+Frozen:     %t
+Transfer:   %s
+IssuerFrozen: %t
+User:       %s
+UserExpires: %d
+Parent:     %s
+Creator:    %s`,
 		bnft.ID,
 		bnft.Owner,
 		bnft.Hash,
@@ -104,6 +206,17 @@ Price:      %v`,
 		bnft.Wins,
 		bnft.Losses,
 		bnft.Price,
+		bnft.Description,
+		bnft.TokenURI,
+		bnft.Traits,
+		bnft.Stats,
+		bnft.Frozen,
+		bnft.GetTransferPolicy(),
+		bnft.IssuerFrozen,
+		bnft.User,
+		bnft.UserExpires,
+		bnft.Parent,
+		bnft.Creator,
 	)
 }
 
@@ -187,9 +300,7 @@ type NFTJSON map[string]BaseNFT
 func (nfts NFTs) MarshalJSON() ([]byte, error) {
 	nftJSON := make(NFTJSON)
 	for _, nft := range nfts {
-		id := nft.GetID()
-		bnft := NewBaseNFT(id, nft.GetOwner(), nft.GetHash(), nft.GetProof(), nft.GetName(), nft.GetWins(), nft.GetLosses(), nft.GetPrice())
-		nftJSON[id] = bnft
+		nftJSON[nft.GetID()] = toBaseNFT(nft.GetID(), nft)
 	}
 	return json.Marshal(nftJSON)
 }
@@ -202,12 +313,30 @@ func (nfts *NFTs) UnmarshalJSON(b []byte) error {
 	}
 
 	for id, nft := range nftJSON {
-		bnft := NewBaseNFT(id, nft.GetOwner(), nft.GetHash(), nft.GetProof(), nft.GetName(), nft.GetWins(), nft.GetLosses(), nft.GetPrice())
+		bnft := toBaseNFT(id, &nft)
 		*nfts = append(*nfts, &bnft)
 	}
+	// map iteration is random, lookups need the NFTs sorted by ID
+	nfts.Sort()
 	return nil
 }
 
+// toBaseNFT copies all the fields of an NFT into a BaseNFT with the given ID
+func toBaseNFT(id string, nft NFT) BaseNFT {
+	bnft := NewBaseNFT(id, nft.GetOwner(), nft.GetHash(), nft.GetProof(), nft.GetName(), nft.GetWins(), nft.GetLosses(), nft.GetPrice())
+	bnft.EditMetadata(nft.GetName(), nft.GetDescription(), nft.GetTokenURI(), nft.GetTraits())
+	bnft.SetStats(nft.GetStats())
+	if nft.IsFrozen() {
+		bnft.Freeze()
+	}
+	bnft.SetTransferPolicy(nft.GetTransferPolicy())
+	bnft.SetIssuerFrozen(nft.IsIssuerFrozen())
+	bnft.SetUser(nft.GetUser(), nft.GetUserExpires())
+	bnft.SetParent(nft.GetParent())
+	bnft.SetCreator(nft.GetCreator())
+	return bnft
+}
+
 // Findable and Sort interfaces
 func (nfts NFTs) ElAtIndex(index int) string { return nfts[index].GetID() }
 func (nfts NFTs) Len() int                   { return len(nfts) }
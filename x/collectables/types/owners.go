@@ -1,6 +1,7 @@
 package types
 
 import (
+	"bytes"
 	"fmt"
 	"sort"
 	"strings"
@@ -163,6 +164,36 @@ func (owner Owner) DeleteID(denom string, id string) (Owner, error) {
 	return owner, nil
 }
 
+// DeriveOwners builds the owners of all the NFTs of the collections from the owner of each NFT,
+// ordered by address like the owners stored by the keeper
+func DeriveOwners(collections Collections) []Owner {
+	var addresses []sdk.AccAddress
+	ids := make(map[string]map[string][]string)
+	for _, collection := range collections {
+		for _, nft := range collection.NFTs {
+			address := nft.GetOwner()
+			denoms, ok := ids[address.String()]
+			if !ok {
+				denoms = make(map[string][]string)
+				ids[address.String()] = denoms
+				addresses = append(addresses, address)
+			}
+			denoms[collection.Denom] = append(denoms[collection.Denom], nft.GetID())
+		}
+	}
+	sort.Slice(addresses, func(i, j int) bool { return bytes.Compare(addresses[i], addresses[j]) < 0 })
+
+	owners := make([]Owner, len(addresses))
+	for i, address := range addresses {
+		var idCollections IDCollections
+		for denom, denomIDs := range ids[address.String()] {
+			idCollections = append(idCollections, NewIDCollection(denom, denomIDs))
+		}
+		owners[i] = NewOwner(address, idCollections.Sort()...)
+	}
+	return owners
+}
+
 // String follows stringer interface
 func (owner Owner) String() string {
 	return fmt.Sprintf(`
@@ -204,7 +235,7 @@ func (idCollections IDCollections) Less(i, j int) bool {
 	return strings.Compare(idCollections[i].Denom, idCollections[j].Denom) == -1
 }
 func (idCollections IDCollections) Swap(i, j int) {
-	idCollections[i].Denom, idCollections[j].Denom = idCollections[j].Denom, idCollections[i].Denom
+	idCollections[i], idCollections[j] = idCollections[j], idCollections[i]
 }
 
 var _ sort.Interface = IDCollections{}
@@ -0,0 +1,76 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+const (
+	// DefaultParamspace is the namespace of the module parameters
+	DefaultParamspace = ModuleName
+
+	// DefaultHistoryLimit is the default number of history entries kept per NFT
+	DefaultHistoryLimit uint64 = 100
+
+	// MaxHistoryLimit is the maximum number of history entries kept per NFT, the whole history
+	// of an NFT is loaded to page through it
+	MaxHistoryLimit uint64 = 10000
+)
+
+// Parameter store keys
+var (
+	KeyHistoryLimit = []byte("HistoryLimit")
+)
+
+// Params are the parameters of the collectables module
+type Params struct {
+	HistoryLimit uint64 `json:"history_limit" yaml:"history_limit"` // number of history entries kept per NFT, the oldest ones are pruned and 0 disables the history
+}
+
+// ParamKeyTable returns the key table of the module parameters
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params instance
+func NewParams(historyLimit uint64) Params {
+	return Params{
+		HistoryLimit: historyLimit,
+	}
+}
+
+// DefaultParams returns the default parameters of the module
+func DefaultParams() Params {
+	return NewParams(DefaultHistoryLimit)
+}
+
+// Validate checks the parameters
+func (p Params) Validate() error {
+	return validateHistoryLimit(p.HistoryLimit)
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		params.NewParamSetPair(KeyHistoryLimit, &p.HistoryLimit, validateHistoryLimit),
+	}
+}
+
+func (p Params) String() string {
+	return fmt.Sprintf(`Params:
+  History Limit: %d`, p.HistoryLimit)
+}
+
+// validateHistoryLimit accepts a limit of 0, which stops recording and prunes the history of an NFT
+// the next time it changes, up to MaxHistoryLimit
+func validateHistoryLimit(i interface{}) error {
+	limit, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if limit > MaxHistoryLimit {
+		return fmt.Errorf("history limit %d is above the maximum of %d", limit, MaxHistoryLimit)
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// TransferPolicy defines whether an NFT can change hands
+type TransferPolicy string
+
+// Transfer policies of a collection or a single NFT, from the least to the most restrictive
+const (
+	TransferPolicyTransferable TransferPolicy = "transferable" // the NFT can be sent, sold, challenged and burned
+	TransferPolicyBurnOnly     TransferPolicy = "burn_only"    // the NFT never changes hands but its owner can burn it
+	TransferPolicySoulbound    TransferPolicy = "soulbound"    // the NFT never changes hands and can't be burned
+)
+
+// ParseTransferPolicy parses a transfer policy, an empty string is the transferable policy
+func ParseTransferPolicy(s string) (TransferPolicy, error) {
+	policy := TransferPolicy(strings.ToLower(strings.TrimSpace(s)))
+	if policy == "" {
+		return TransferPolicyTransferable, nil
+	}
+	return policy, policy.Validate()
+}
+
+// Validate checks that a policy is known, the empty policy is allowed and means transferable
+func (policy TransferPolicy) Validate() error {
+	switch policy {
+	case "", TransferPolicyTransferable, TransferPolicyBurnOnly, TransferPolicySoulbound:
+		return nil
+	default:
+		return sdkerrors.Wrap(ErrInvalidTransferPolicy,
+			fmt.Sprintf("unknown transfer policy %s, expected %s, %s or %s",
+				policy, TransferPolicyTransferable, TransferPolicyBurnOnly, TransferPolicySoulbound),
+		)
+	}
+}
+
+// Transferable returns true if an NFT with this policy can change hands
+func (policy TransferPolicy) Transferable() bool {
+	return policy == "" || policy == TransferPolicyTransferable
+}
+
+// Burnable returns true if an NFT with this policy can be burned by its owner
+func (policy TransferPolicy) Burnable() bool {
+	return policy != TransferPolicySoulbound
+}
+
+// Stricter returns the most restrictive of two policies
+func (policy TransferPolicy) Stricter(other TransferPolicy) TransferPolicy {
+	if other.level() > policy.level() {
+		return other
+	}
+	return policy.Normalize()
+}
+
+// Normalize returns the transferable policy for the empty policy of NFTs minted before policies existed
+func (policy TransferPolicy) Normalize() TransferPolicy {
+	if policy == "" {
+		return TransferPolicyTransferable
+	}
+	return policy
+}
+
+func (policy TransferPolicy) level() int {
+	switch policy {
+	case TransferPolicySoulbound:
+		return 2
+	case TransferPolicyBurnOnly:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func (policy TransferPolicy) String() string { return string(policy.Normalize()) }
+
+// CheckTransferable returns an error if an NFT can't change hands, because of its transfer
+// policy, because the collection issuer froze it, because it is escrowed or because it is rented
+func CheckTransferable(nft NFT) error {
+	if err := CheckUsable(nft); err != nil {
+		return err
+	}
+	if nft.GetOwner().Equals(EscrowAddress) {
+		return sdkerrors.Wrap(ErrEscrowed, fmt.Sprintf("NFT #%s is locked by the module", nft.GetID()))
+	}
+	if IsRented(nft) {
+		return sdkerrors.Wrap(ErrRented, fmt.Sprintf("NFT #%s is rented until block %d", nft.GetID(), nft.GetUserExpires()))
+	}
+	return nil
+}
+
+// CheckUsable returns an error if an NFT can't be used in challenges, because of its transfer
+// policy or because the collection issuer froze it. Rented NFTs can be used by their user.
+func CheckUsable(nft NFT) error {
+	if nft.IsIssuerFrozen() {
+		return sdkerrors.Wrap(ErrIssuerFrozen, fmt.Sprintf("NFT #%s is frozen", nft.GetID()))
+	}
+	if !nft.GetTransferPolicy().Transferable() {
+		return sdkerrors.Wrap(ErrNonTransferable, fmt.Sprintf("NFT #%s is %s", nft.GetID(), nft.GetTransferPolicy()))
+	}
+	return nil
+}
+
+// CheckBurnable returns an error if the owner of an NFT isn't allowed to burn it
+func CheckBurnable(nft NFT) error {
+	if nft.IsIssuerFrozen() {
+		return sdkerrors.Wrap(ErrIssuerFrozen, fmt.Sprintf("NFT #%s is frozen", nft.GetID()))
+	}
+	if IsRented(nft) {
+		return sdkerrors.Wrap(ErrRented, fmt.Sprintf("NFT #%s is rented until block %d", nft.GetID(), nft.GetUserExpires()))
+	}
+	if !nft.GetTransferPolicy().Burnable() {
+		return sdkerrors.Wrap(ErrNotBurnable, fmt.Sprintf("NFT #%s is %s", nft.GetID(), nft.GetTransferPolicy()))
+	}
+	return nil
+}
@@ -0,0 +1,482 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/tosch110/collectables/x/collectables/types/v1beta1"
+)
+
+// NFTToProto converts an NFT to its protobuf definition
+func NFTToProto(nft NFT) v1beta1.BaseNFT {
+	pnft := v1beta1.BaseNFT{
+		ID:             nft.GetID(),
+		Owner:          nft.GetOwner(),
+		Hash:           nft.GetHash(),
+		Proof:          nft.GetProof(),
+		Name:           nft.GetName(),
+		Wins:           uint64(nft.GetWins()),
+		Losses:         uint64(nft.GetLosses()),
+		Price:          coinsToProto(nft.GetPrice()),
+		Description:    nft.GetDescription(),
+		TokenURI:       nft.GetTokenURI(),
+		Stats:          statsToProto(nft.GetStats()),
+		Frozen:         nft.IsFrozen(),
+		TransferPolicy: string(nft.GetTransferPolicy()),
+		IssuerFrozen:   nft.IsIssuerFrozen(),
+		User:           nft.GetUser(),
+		UserExpires:    nft.GetUserExpires(),
+		Parent:         v1beta1.NFTRef{Denom: nft.GetParent().Denom, ID: nft.GetParent().ID},
+		Creator:        nft.GetCreator(),
+	}
+	for _, trait := range nft.GetTraits() {
+		pnft.Traits = append(pnft.Traits, v1beta1.Trait{Key: trait.Key, Type: trait.Type, Value: trait.Value})
+	}
+	return pnft
+}
+
+// NFTFromProto converts the protobuf definition of an NFT back to a BaseNFT
+func NFTFromProto(pnft v1beta1.BaseNFT) (*BaseNFT, error) {
+	price, err := coinsFromProto(pnft.Price)
+	if err != nil {
+		return nil, err
+	}
+
+	nft := &BaseNFT{
+		ID:             pnft.ID,
+		Owner:          addressFromProto(pnft.Owner),
+		Hash:           pnft.Hash,
+		Proof:          pnft.Proof,
+		Name:           pnft.Name,
+		Wins:           uint(pnft.Wins),
+		Losses:         uint(pnft.Losses),
+		Price:          price,
+		Description:    pnft.Description,
+		TokenURI:       pnft.TokenURI,
+		Stats:          NewStats(uint(pnft.Stats.Version), uint(pnft.Stats.Attack), uint(pnft.Stats.Defense), uint(pnft.Stats.Speed), pnft.Stats.Rarity),
+		Frozen:         pnft.Frozen,
+		TransferPolicy: TransferPolicy(pnft.TransferPolicy),
+		IssuerFrozen:   pnft.IssuerFrozen,
+		User:           addressFromProto(pnft.User),
+		UserExpires:    pnft.UserExpires,
+		Parent:         NewNFTRef(pnft.Parent.Denom, pnft.Parent.ID),
+		Creator:        addressFromProto(pnft.Creator),
+	}
+	for _, trait := range pnft.Traits {
+		nft.Traits = append(nft.Traits, Trait{Key: trait.Key, Type: trait.Type, Value: trait.Value})
+	}
+	return nft, nil
+}
+
+// ToProto converts the collection to its protobuf definition
+func (collection Collection) ToProto() v1beta1.Collection {
+	pc := v1beta1.Collection{
+		Denom:            collection.Denom,
+		Creator:          collection.Creator,
+		GeneratorVersion: uint64(collection.GeneratorVersion),
+		Frozen:           collection.Frozen,
+		Commitment:       collection.Commitment,
+		Revealed:         collection.Revealed,
+		TransferPolicy:   string(collection.TransferPolicy),
+		Issuer:           collection.Issuer,
+	}
+	for _, nft := range collection.NFTs {
+		pc.NFTs = append(pc.NFTs, NFTToProto(nft))
+	}
+	for _, def := range collection.Schema {
+		pc.Schema = append(pc.Schema, v1beta1.TraitDefinition{Key: def.Key, Type: def.Type})
+	}
+	for _, capability := range collection.IssuerCapabilities {
+		pc.IssuerCapabilities = append(pc.IssuerCapabilities, string(capability))
+	}
+	return pc
+}
+
+// CollectionFromProto converts the protobuf definition of a collection back to a Collection
+func CollectionFromProto(pc v1beta1.Collection) (Collection, error) {
+	collection := Collection{
+		Denom:            pc.Denom,
+		Creator:          addressFromProto(pc.Creator),
+		GeneratorVersion: uint(pc.GeneratorVersion),
+		Frozen:           pc.Frozen,
+		Commitment:       pc.Commitment,
+		Revealed:         pc.Revealed,
+		TransferPolicy:   TransferPolicy(pc.TransferPolicy),
+		Issuer:           addressFromProto(pc.Issuer),
+	}
+	for _, pnft := range pc.NFTs {
+		nft, err := NFTFromProto(pnft)
+		if err != nil {
+			return Collection{}, err
+		}
+		collection.NFTs = append(collection.NFTs, nft)
+	}
+	for _, def := range pc.Schema {
+		collection.Schema = append(collection.Schema, TraitDefinition{Key: def.Key, Type: def.Type})
+	}
+	for _, capability := range pc.IssuerCapabilities {
+		collection.IssuerCapabilities = append(collection.IssuerCapabilities, IssuerCapability(capability))
+	}
+	return collection, nil
+}
+
+// MustMarshalCollection encodes a collection with protobuf, the encoding of the store since v5
+func MustMarshalCollection(collection Collection) []byte {
+	pc := collection.ToProto()
+	return mustMarshal(&pc)
+}
+
+// MustUnmarshalCollection decodes a collection encoded by MustMarshalCollection
+func MustUnmarshalCollection(bz []byte) Collection {
+	var pc v1beta1.Collection
+	mustUnmarshal(bz, &pc)
+	collection, err := CollectionFromProto(pc)
+	if err != nil {
+		panic(err)
+	}
+	return collection
+}
+
+// ToProto converts the owner to its protobuf definition
+func (owner Owner) ToProto() v1beta1.Owner {
+	powner := v1beta1.Owner{Address: owner.Address}
+	for _, idCollection := range owner.IDCollections {
+		powner.IDCollections = append(powner.IDCollections, v1beta1.IDCollection{Denom: idCollection.Denom, IDs: idCollection.IDs})
+	}
+	return powner
+}
+
+// MustMarshalIDCollection encodes the IDs of the NFTs of a collection held by an owner with protobuf
+func MustMarshalIDCollection(idCollection IDCollection) []byte {
+	return mustMarshal(&v1beta1.IDCollection{Denom: idCollection.Denom, IDs: idCollection.IDs})
+}
+
+// MustUnmarshalIDCollection decodes the IDs of the NFTs encoded by MustMarshalIDCollection
+func MustUnmarshalIDCollection(bz []byte) IDCollection {
+	var pidc v1beta1.IDCollection
+	mustUnmarshal(bz, &pidc)
+	return IDCollection{Denom: pidc.Denom, IDs: pidc.IDs}
+}
+
+// MustMarshalNFTRef encodes a reference to an NFT with protobuf, the value of the indexes of the store
+func MustMarshalNFTRef(ref NFTRef) []byte {
+	return mustMarshal(&v1beta1.NFTRef{Denom: ref.Denom, ID: ref.ID})
+}
+
+// MustUnmarshalNFTRef decodes a reference to an NFT encoded by MustMarshalNFTRef
+func MustUnmarshalNFTRef(bz []byte) NFTRef {
+	var pref v1beta1.NFTRef
+	mustUnmarshal(bz, &pref)
+	return NewNFTRef(pref.Denom, pref.ID)
+}
+
+// ToProto converts the loan to its protobuf definition
+func (loan Loan) ToProto() v1beta1.Loan {
+	return v1beta1.Loan{
+		ID:        loan.ID,
+		Borrower:  loan.Borrower,
+		Lender:    loan.Lender,
+		Denom:     loan.Denom,
+		NFTID:     loan.NFTID,
+		Principal: coinsToProto(loan.Principal),
+		Interest:  coinsToProto(loan.Interest),
+		Duration:  loan.Duration,
+		DueHeight: loan.DueHeight,
+	}
+}
+
+// LoanFromProto converts the protobuf definition of a loan back to a Loan
+func LoanFromProto(pl v1beta1.Loan) (Loan, error) {
+	principal, err := coinsFromProto(pl.Principal)
+	if err != nil {
+		return Loan{}, err
+	}
+	interest, err := coinsFromProto(pl.Interest)
+	if err != nil {
+		return Loan{}, err
+	}
+	return Loan{
+		ID:        pl.ID,
+		Borrower:  addressFromProto(pl.Borrower),
+		Lender:    addressFromProto(pl.Lender),
+		Denom:     pl.Denom,
+		NFTID:     pl.NFTID,
+		Principal: principal,
+		Interest:  interest,
+		Duration:  pl.Duration,
+		DueHeight: pl.DueHeight,
+	}, nil
+}
+
+// MustMarshalLoan encodes a loan with protobuf
+func MustMarshalLoan(loan Loan) []byte {
+	pl := loan.ToProto()
+	return mustMarshal(&pl)
+}
+
+// MustUnmarshalLoan decodes a loan encoded by MustMarshalLoan
+func MustUnmarshalLoan(bz []byte) Loan {
+	var pl v1beta1.Loan
+	mustUnmarshal(bz, &pl)
+	loan, err := LoanFromProto(pl)
+	if err != nil {
+		panic(err)
+	}
+	return loan
+}
+
+// ToProto converts the vault to its protobuf definition
+func (vault Vault) ToProto() v1beta1.Vault {
+	return v1beta1.Vault{
+		Denom:        vault.Denom,
+		NFTID:        vault.NFTID,
+		Curator:      vault.Curator,
+		ShareDenom:   vault.ShareDenom,
+		Shares:       vault.Shares.String(),
+		ReservePrice: coinsToProto(vault.ReservePrice),
+		Buyer:        vault.Buyer,
+		Proceeds:     coinsToProto(vault.Proceeds),
+	}
+}
+
+// VaultFromProto converts the protobuf definition of a vault back to a Vault
+func VaultFromProto(pv v1beta1.Vault) (Vault, error) {
+	shares, ok := sdk.NewIntFromString(pv.Shares)
+	if !ok {
+		return Vault{}, sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, fmt.Sprintf("invalid amount %s of %s", pv.Shares, pv.ShareDenom))
+	}
+	reservePrice, err := coinsFromProto(pv.ReservePrice)
+	if err != nil {
+		return Vault{}, err
+	}
+	proceeds, err := coinsFromProto(pv.Proceeds)
+	if err != nil {
+		return Vault{}, err
+	}
+	return Vault{
+		Denom:        pv.Denom,
+		NFTID:        pv.NFTID,
+		Curator:      addressFromProto(pv.Curator),
+		ShareDenom:   pv.ShareDenom,
+		Shares:       shares,
+		ReservePrice: reservePrice,
+		Buyer:        addressFromProto(pv.Buyer),
+		Proceeds:     proceeds,
+	}, nil
+}
+
+// MustMarshalVault encodes a vault with protobuf
+func MustMarshalVault(vault Vault) []byte {
+	pv := vault.ToProto()
+	return mustMarshal(&pv)
+}
+
+// MustUnmarshalVault decodes a vault encoded by MustMarshalVault
+func MustUnmarshalVault(bz []byte) Vault {
+	var pv v1beta1.Vault
+	mustUnmarshal(bz, &pv)
+	vault, err := VaultFromProto(pv)
+	if err != nil {
+		panic(err)
+	}
+	return vault
+}
+
+// ToProto converts the bundle to its protobuf definition
+func (bundle Bundle) ToProto() v1beta1.Bundle {
+	return v1beta1.Bundle{ID: bundle.ID, Components: refsToProto(bundle.Components)}
+}
+
+// BundleFromProto converts the protobuf definition of a bundle back to a Bundle
+func BundleFromProto(pb v1beta1.Bundle) Bundle {
+	return Bundle{ID: pb.ID, Components: refsFromProto(pb.Components)}
+}
+
+// MustMarshalBundle encodes a bundle with protobuf
+func MustMarshalBundle(bundle Bundle) []byte {
+	pb := bundle.ToProto()
+	return mustMarshal(&pb)
+}
+
+// MustUnmarshalBundle decodes a bundle encoded by MustMarshalBundle
+func MustUnmarshalBundle(bz []byte) Bundle {
+	var pb v1beta1.Bundle
+	mustUnmarshal(bz, &pb)
+	return BundleFromProto(pb)
+}
+
+// ToProto converts the swap to its protobuf definition
+func (swap Swap) ToProto() v1beta1.Swap {
+	return v1beta1.Swap{
+		ID:             swap.ID,
+		Proposer:       swap.Proposer,
+		Counterparty:   swap.Counterparty,
+		OfferedNFTs:    refsToProto(swap.OfferedNFTs),
+		OfferedCoins:   coinsToProto(swap.OfferedCoins),
+		RequestedNFTs:  refsToProto(swap.RequestedNFTs),
+		RequestedCoins: coinsToProto(swap.RequestedCoins),
+	}
+}
+
+// SwapFromProto converts the protobuf definition of a swap back to a Swap
+func SwapFromProto(ps v1beta1.Swap) (Swap, error) {
+	offeredCoins, err := coinsFromProto(ps.OfferedCoins)
+	if err != nil {
+		return Swap{}, err
+	}
+	requestedCoins, err := coinsFromProto(ps.RequestedCoins)
+	if err != nil {
+		return Swap{}, err
+	}
+	return Swap{
+		ID:             ps.ID,
+		Proposer:       addressFromProto(ps.Proposer),
+		Counterparty:   addressFromProto(ps.Counterparty),
+		OfferedNFTs:    refsFromProto(ps.OfferedNFTs),
+		OfferedCoins:   offeredCoins,
+		RequestedNFTs:  refsFromProto(ps.RequestedNFTs),
+		RequestedCoins: requestedCoins,
+	}, nil
+}
+
+// MustMarshalSwap encodes a swap with protobuf
+func MustMarshalSwap(swap Swap) []byte {
+	ps := swap.ToProto()
+	return mustMarshal(&ps)
+}
+
+// MustUnmarshalSwap decodes a swap encoded by MustMarshalSwap
+func MustUnmarshalSwap(bz []byte) Swap {
+	var ps v1beta1.Swap
+	mustUnmarshal(bz, &ps)
+	swap, err := SwapFromProto(ps)
+	if err != nil {
+		panic(err)
+	}
+	return swap
+}
+
+// ToProto converts the history entry to its protobuf definition
+func (entry HistoryEntry) ToProto() v1beta1.HistoryEntry {
+	return v1beta1.HistoryEntry{
+		Denom:    entry.Denom,
+		ID:       entry.ID,
+		Sequence: entry.Sequence,
+		Height:   entry.Height,
+		Action:   string(entry.Action),
+		From:     entry.From,
+		To:       entry.To,
+		Price:    coinsToProto(entry.Price),
+		Opponent: v1beta1.NFTRef{Denom: entry.Opponent.Denom, ID: entry.Opponent.ID},
+		Outcome:  entry.Outcome,
+	}
+}
+
+// HistoryEntryFromProto converts the protobuf definition of a history entry back to a HistoryEntry
+func HistoryEntryFromProto(pe v1beta1.HistoryEntry) (HistoryEntry, error) {
+	price, err := coinsFromProto(pe.Price)
+	if err != nil {
+		return HistoryEntry{}, err
+	}
+	return HistoryEntry{
+		Denom:    pe.Denom,
+		ID:       pe.ID,
+		Sequence: pe.Sequence,
+		Height:   pe.Height,
+		Action:   HistoryAction(pe.Action),
+		From:     addressFromProto(pe.From),
+		To:       addressFromProto(pe.To),
+		Price:    price,
+		Opponent: NewNFTRef(pe.Opponent.Denom, pe.Opponent.ID),
+		Outcome:  pe.Outcome,
+	}, nil
+}
+
+// MustMarshalHistoryEntry encodes a history entry with protobuf
+func MustMarshalHistoryEntry(entry HistoryEntry) []byte {
+	pe := entry.ToProto()
+	return mustMarshal(&pe)
+}
+
+// MustUnmarshalHistoryEntry decodes a history entry encoded by MustMarshalHistoryEntry
+func MustUnmarshalHistoryEntry(bz []byte) HistoryEntry {
+	var pe v1beta1.HistoryEntry
+	mustUnmarshal(bz, &pe)
+	entry, err := HistoryEntryFromProto(pe)
+	if err != nil {
+		panic(err)
+	}
+	return entry
+}
+
+func statsToProto(stats Stats) v1beta1.Stats {
+	return v1beta1.Stats{
+		Version: uint64(stats.Version),
+		Attack:  uint64(stats.Attack),
+		Defense: uint64(stats.Defense),
+		Speed:   uint64(stats.Speed),
+		Rarity:  stats.Rarity,
+	}
+}
+
+func coinsToProto(coins sdk.Coins) (pcoins []v1beta1.Coin) {
+	for _, coin := range coins {
+		pcoins = append(pcoins, v1beta1.Coin{Denom: coin.Denom, Amount: coin.Amount.String()})
+	}
+	return
+}
+
+func coinsFromProto(pcoins []v1beta1.Coin) (coins sdk.Coins, err error) {
+	for _, pcoin := range pcoins {
+		amount, ok := sdk.NewIntFromString(pcoin.Amount)
+		if !ok {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, fmt.Sprintf("invalid amount %s of %s", pcoin.Amount, pcoin.Denom))
+		}
+		coins = append(coins, sdk.Coin{Denom: pcoin.Denom, Amount: amount})
+	}
+	return
+}
+
+func refsToProto(refs []NFTRef) (prefs []v1beta1.NFTRef) {
+	for _, ref := range refs {
+		prefs = append(prefs, v1beta1.NFTRef{Denom: ref.Denom, ID: ref.ID})
+	}
+	return
+}
+
+func refsFromProto(prefs []v1beta1.NFTRef) (refs []NFTRef) {
+	for _, pref := range prefs {
+		refs = append(refs, NewNFTRef(pref.Denom, pref.ID))
+	}
+	return
+}
+
+// addressFromProto returns the address of an address field, which is empty if the address isn't set
+func addressFromProto(address sdk.AccAddress) sdk.AccAddress {
+	if len(address) == 0 {
+		return nil
+	}
+	return address
+}
+
+// protoMessage is implemented by the protobuf definitions of the store values
+type protoMessage interface {
+	Marshal() ([]byte, error)
+	Unmarshal([]byte) error
+}
+
+func mustMarshal(msg protoMessage) []byte {
+	bz, err := msg.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+func mustUnmarshal(bz []byte, msg protoMessage) {
+	if err := msg.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+}
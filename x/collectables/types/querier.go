@@ -4,6 +4,9 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// DefaultQueryLimit is the default number of NFTs per page of the paginated queries
+const DefaultQueryLimit = 100
+
 // QueryCollectionParams defines the params for queries:
 // - 'custom/nft/supply'
 // - 'custom/nft/collection'
@@ -51,3 +54,129 @@ func NewQueryNFTParams(denom, id string) QueryNFTParams {
 		TokenID: id,
 	}
 }
+
+// QueryHashParams params for query 'custom/nfts/byHash'
+type QueryHashParams struct {
+	Hash string
+}
+
+// NewQueryHashParams creates a new instance of QueryHashParams
+func NewQueryHashParams(hash string) QueryHashParams {
+	return QueryHashParams{Hash: hash}
+}
+
+// QuerySearchParams params for query 'custom/nfts/search'
+type QuerySearchParams struct {
+	Denom  string // optional
+	Traits Traits
+}
+
+// NewQuerySearchParams creates a new instance of QuerySearchParams
+func NewQuerySearchParams(denom string, traits Traits) QuerySearchParams {
+	return QuerySearchParams{
+		Denom:  denom,
+		Traits: traits,
+	}
+}
+
+// QueryLoanParams params for query 'custom/nfts/loan'
+type QueryLoanParams struct {
+	ID uint64
+}
+
+// NewQueryLoanParams creates a new instance of QueryLoanParams
+func NewQueryLoanParams(id uint64) QueryLoanParams {
+	return QueryLoanParams{ID: id}
+}
+
+// QueryLoansParams params for query 'custom/nfts/loans'
+type QueryLoansParams struct {
+	Borrower sdk.AccAddress // optional
+	Lender   sdk.AccAddress // optional
+}
+
+// NewQueryLoansParams creates a new instance of QueryLoansParams
+func NewQueryLoansParams(borrower, lender sdk.AccAddress) QueryLoansParams {
+	return QueryLoansParams{
+		Borrower: borrower,
+		Lender:   lender,
+	}
+}
+
+// QueryVaultsParams params for query 'custom/nfts/vaults'
+type QueryVaultsParams struct {
+	Denom string // optional
+}
+
+// NewQueryVaultsParams creates a new instance of QueryVaultsParams
+func NewQueryVaultsParams(denom string) QueryVaultsParams {
+	return QueryVaultsParams{Denom: denom}
+}
+
+// QueryBundleParams params for query 'custom/nfts/bundle'
+type QueryBundleParams struct {
+	ID string
+}
+
+// NewQueryBundleParams creates a new instance of QueryBundleParams
+func NewQueryBundleParams(id string) QueryBundleParams {
+	return QueryBundleParams{ID: id}
+}
+
+// QuerySwapParams params for query 'custom/nfts/swap'
+type QuerySwapParams struct {
+	ID uint64
+}
+
+// NewQuerySwapParams creates a new instance of QuerySwapParams
+func NewQuerySwapParams(id uint64) QuerySwapParams {
+	return QuerySwapParams{ID: id}
+}
+
+// QuerySwapsParams params for query 'custom/nfts/swaps'
+type QuerySwapsParams struct {
+	Proposer     sdk.AccAddress // optional
+	Counterparty sdk.AccAddress // optional
+}
+
+// NewQuerySwapsParams creates a new instance of QuerySwapsParams
+func NewQuerySwapsParams(proposer, counterparty sdk.AccAddress) QuerySwapsParams {
+	return QuerySwapsParams{
+		Proposer:     proposer,
+		Counterparty: counterparty,
+	}
+}
+
+// QueryHistoryParams params for query 'custom/nfts/history'
+type QueryHistoryParams struct {
+	Denom   string
+	TokenID string
+	Page    int
+	Limit   int
+}
+
+// NewQueryHistoryParams creates a new instance of QueryHistoryParams
+func NewQueryHistoryParams(denom, id string, page, limit int) QueryHistoryParams {
+	return QueryHistoryParams{
+		Denom:   denom,
+		TokenID: id,
+		Page:    page,
+		Limit:   limit,
+	}
+}
+
+// QueryAddressParams params for queries 'custom/nfts/createdBy' and 'custom/nfts/heldBy'
+type QueryAddressParams struct {
+	Address sdk.AccAddress
+	Page    int
+	Limit   int
+}
+
+// NewQueryAddressParams creates a new instance of QueryAddressParams
+func NewQueryAddressParams(address sdk.AccAddress, page, limit int) QueryAddressParams {
+	return QueryAddressParams{
+		Address: address,
+		Page:    page,
+		Limit:   limit,
+	}
+}
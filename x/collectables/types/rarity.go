@@ -0,0 +1,78 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// StatsRarityTraitKey is the key under which the rarity tier of the stats counts as a trait
+const StatsRarityTraitKey = "stats.rarity"
+
+// RarityTraits returns the traits of an NFT that count towards its rarity score:
+// its on-chain traits and the rarity tier of its stats
+func RarityTraits(nft NFT) Traits {
+	traits := append(Traits{}, nft.GetTraits()...)
+	if !nft.GetStats().Empty() {
+		traits = append(traits, NewTrait(StatsRarityTraitKey, TraitTypeString, nft.GetStats().Rarity))
+	}
+	return traits
+}
+
+// Rarity is the rarity score and rank of an NFT within its collection
+type Rarity struct {
+	Denom string  `json:"denom" yaml:"denom"`
+	ID    string  `json:"id" yaml:"id"`
+	Score sdk.Dec `json:"score" yaml:"score"` // sum of supply/frequency of each trait of the NFT
+	Rank  uint64  `json:"rank" yaml:"rank"`   // 1 is the rarest NFT of the collection
+}
+
+// NewRarity creates a new Rarity instance
+func NewRarity(denom, id string, score sdk.Dec) Rarity {
+	return Rarity{
+		Denom: denom,
+		ID:    id,
+		Score: score,
+	}
+}
+
+// String follows stringer interface
+func (rarity Rarity) String() string {
+	return fmt.Sprintf(`Denom:	%s
+ID:		%s
+Score:	%s
+Rank:	%d`,
+		rarity.Denom,
+		rarity.ID,
+		rarity.Score,
+		rarity.Rank,
+	)
+}
+
+// Rarities define a list of Rarity
+type Rarities []Rarity
+
+// Rank sorts the rarities by descending score, then by ID, and sets their rank
+func (rarities Rarities) Rank() Rarities {
+	sort.SliceStable(rarities, func(i, j int) bool {
+		if !rarities[i].Score.Equal(rarities[j].Score) {
+			return rarities[i].Score.GT(rarities[j].Score)
+		}
+		return strings.Compare(rarities[i].ID, rarities[j].ID) == -1
+	})
+	for i := range rarities {
+		rarities[i].Rank = uint64(i + 1)
+	}
+	return rarities
+}
+
+// String follows stringer interface
+func (rarities Rarities) String() string {
+	out := make([]string, len(rarities))
+	for i, rarity := range rarities {
+		out[i] = fmt.Sprintf("%d. %s (%s)", rarity.Rank, rarity.ID, rarity.Score)
+	}
+	return strings.Join(out, "\n")
+}
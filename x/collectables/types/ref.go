@@ -0,0 +1,48 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NFTRef references a single NFT by its collection denom and ID
+type NFTRef struct {
+	Denom string `json:"denom" yaml:"denom"`
+	ID    string `json:"id" yaml:"id"`
+}
+
+// NewNFTRef creates a new NFTRef instance
+func NewNFTRef(denom, id string) NFTRef {
+	return NFTRef{
+		Denom: denom,
+		ID:    id,
+	}
+}
+
+// String follows stringer interface
+func (ref NFTRef) String() string {
+	return fmt.Sprintf("%s/%s", ref.Denom, ref.ID)
+}
+
+// ParseNFTRef parses an NFT reference formatted as denom/id
+func ParseNFTRef(s string) (NFTRef, error) {
+	parts := strings.SplitN(strings.TrimSpace(s), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return NFTRef{}, sdkerrors.Wrap(ErrInvalidNFT, fmt.Sprintf("expected denom/id, got %s", s))
+	}
+	return NewNFTRef(parts[0], parts[1]), nil
+}
+
+// NFTRefs is a list of NFT references
+type NFTRefs []NFTRef
+
+// String follows stringer interface
+func (refs NFTRefs) String() string {
+	out := make([]string, len(refs))
+	for i, ref := range refs {
+		out[i] = ref.String()
+	}
+	return strings.Join(out, ",")
+}
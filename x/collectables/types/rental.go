@@ -0,0 +1,19 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// IsRented returns true while an account other than the owner holds the usage rights of an NFT
+func IsRented(nft NFT) bool {
+	return !nft.GetUser().Empty()
+}
+
+// CanUse returns true if the address holds the usage rights of an NFT: its current user
+// while it is rented and its owner otherwise
+func CanUse(nft NFT, address sdk.AccAddress) bool {
+	if IsRented(nft) {
+		return nft.GetUser().Equals(address)
+	}
+	return nft.GetOwner().Equals(address)
+}
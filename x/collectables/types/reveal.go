@@ -0,0 +1,109 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"lukechampine.com/blake3"
+)
+
+// NFTMetadata is the final metadata of a single NFT published by a reveal
+type NFTMetadata struct {
+	ID          string `json:"id" yaml:"id"`
+	Name        string `json:"name" yaml:"name"`
+	Description string `json:"description" yaml:"description"`
+	TokenURI    string `json:"token_uri" yaml:"token_uri"`
+	Traits      Traits `json:"traits" yaml:"traits"`
+}
+
+// NewNFTMetadata creates a new NFTMetadata instance
+func NewNFTMetadata(id, name, description, tokenURI string, traits Traits) NFTMetadata {
+	return NFTMetadata{
+		ID:          strings.TrimSpace(id),
+		Name:        strings.TrimSpace(name),
+		Description: strings.TrimSpace(description),
+		TokenURI:    strings.TrimSpace(tokenURI),
+		Traits:      traits.Sort(),
+	}
+}
+
+// RevealMetadata is the metadata of all the NFTs of a collection published by a reveal
+type RevealMetadata []NFTMetadata
+
+// Validate checks that every entry has a unique ID and valid traits
+func (metadata RevealMetadata) Validate() error {
+	if len(metadata) == 0 {
+		return sdkerrors.Wrap(ErrEmptyMetadata, "a reveal needs the metadata of at least one NFT")
+	}
+	seen := make(map[string]bool, len(metadata))
+	for _, m := range metadata {
+		if strings.TrimSpace(m.ID) == "" {
+			return ErrInvalidNFT
+		}
+		if seen[m.ID] {
+			return sdkerrors.Wrap(ErrInvalidNFT, fmt.Sprintf("duplicate metadata for NFT #%s", m.ID))
+		}
+		seen[m.ID] = true
+		if err := ValidateMetadata(m.Description, m.TokenURI, m.Traits); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Commitment returns the hex encoded blake3 digest of the sorted JSON encoding of the
+// metadata. The entries and their traits are ordered first so the commitment doesn't depend on it.
+func (metadata RevealMetadata) Commitment() string {
+	sorted := make(RevealMetadata, len(metadata))
+	for i, m := range metadata {
+		// normalize the traits so that empty and missing traits encode the same way
+		var traits Traits
+		if len(m.Traits) > 0 {
+			traits = append(Traits{}, m.Traits...).Sort()
+		}
+		sorted[i] = NewNFTMetadata(m.ID, m.Name, m.Description, m.TokenURI, traits)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].ID < sorted[j].ID })
+
+	bz := sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(sorted))
+	sum := blake3.Sum256(bz)
+	return hex.EncodeToString(sum[:])
+}
+
+// ValidateCommitment checks that a reveal commitment is a lowercase hex encoded blake3 digest
+func ValidateCommitment(commitment string) error {
+	if err := ValidateHash(commitment); err != nil {
+		return sdkerrors.Wrap(ErrInvalidCommitment, err.Error())
+	}
+	return nil
+}
+
+// RevealStatus tells whether the metadata of a collection is still hidden behind a commitment
+type RevealStatus struct {
+	Denom      string `json:"denom" yaml:"denom"`
+	Commitment string `json:"commitment" yaml:"commitment"` // empty if the collection has no delayed reveal
+	Revealed   bool   `json:"revealed" yaml:"revealed"`
+}
+
+// NewRevealStatus returns the reveal status of a collection
+func NewRevealStatus(collection Collection) RevealStatus {
+	return RevealStatus{
+		Denom:      collection.Denom,
+		Commitment: collection.Commitment,
+		Revealed:   collection.IsRevealed(),
+	}
+}
+
+func (status RevealStatus) String() string {
+	return fmt.Sprintf(`Denom:      %s
+Commitment: %s
+Revealed:   %t`,
+		status.Denom,
+		status.Commitment,
+		status.Revealed,
+	)
+}
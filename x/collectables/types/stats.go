@@ -0,0 +1,54 @@
+package types
+
+import (
+	"fmt"
+)
+
+// Rarity tiers of an NFT from the most to the least common
+const (
+	RarityCommon    = "common"
+	RarityUncommon  = "uncommon"
+	RarityRare      = "rare"
+	RarityEpic      = "epic"
+	RarityLegendary = "legendary"
+)
+
+// Stats are the game stats of an NFT, derived from its hash at mint time
+type Stats struct {
+	Version uint   `json:"version" yaml:"version"` // version of the trait generator that derived the stats
+	Attack  uint   `json:"attack" yaml:"attack"`
+	Defense uint   `json:"defense" yaml:"defense"`
+	Speed   uint   `json:"speed" yaml:"speed"`
+	Rarity  string `json:"rarity" yaml:"rarity"`
+}
+
+// NewStats creates a new Stats instance
+func NewStats(version, attack, defense, speed uint, rarity string) Stats {
+	return Stats{
+		Version: version,
+		Attack:  attack,
+		Defense: defense,
+		Speed:   speed,
+		Rarity:  rarity,
+	}
+}
+
+// Empty returns true if the stats were never derived
+func (stats Stats) Empty() bool {
+	return stats.Version == 0
+}
+
+// WithBonus returns the stats increased by the attack, defense and speed of a bonus, e.g. equipment
+func (stats Stats) WithBonus(bonus Stats) Stats {
+	stats.Attack += bonus.Attack
+	stats.Defense += bonus.Defense
+	stats.Speed += bonus.Speed
+	return stats
+}
+
+// String follows stringer interface
+func (stats Stats) String() string {
+	return fmt.Sprintf("attack=%d defense=%d speed=%d rarity=%s (v%d)",
+		stats.Attack, stats.Defense, stats.Speed, stats.Rarity, stats.Version,
+	)
+}
@@ -0,0 +1,111 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxSwapSize is the maximum number of NFTs exchanged by a swap
+const MaxSwapSize = 50
+
+// Swap is an offer of NFTs and coins held in escrow in exchange for NFTs and coins of a counterparty
+type Swap struct {
+	ID             uint64         `json:"id" yaml:"id"`
+	Proposer       sdk.AccAddress `json:"proposer" yaml:"proposer"`
+	Counterparty   sdk.AccAddress `json:"counterparty" yaml:"counterparty"`
+	OfferedNFTs    []NFTRef       `json:"offered_nfts" yaml:"offered_nfts"`       // NFTs of the proposer held in escrow
+	OfferedCoins   sdk.Coins      `json:"offered_coins" yaml:"offered_coins"`     // coins of the proposer held in escrow
+	RequestedNFTs  []NFTRef       `json:"requested_nfts" yaml:"requested_nfts"`   // NFTs of the counterparty
+	RequestedCoins sdk.Coins      `json:"requested_coins" yaml:"requested_coins"` // coins of the counterparty
+}
+
+// NewSwap creates a new swap
+func NewSwap(id uint64, proposer, counterparty sdk.AccAddress, offeredNFTs []NFTRef, offeredCoins sdk.Coins,
+	requestedNFTs []NFTRef, requestedCoins sdk.Coins) Swap {
+	return Swap{
+		ID:             id,
+		Proposer:       proposer,
+		Counterparty:   counterparty,
+		OfferedNFTs:    offeredNFTs,
+		OfferedCoins:   offeredCoins,
+		RequestedNFTs:  requestedNFTs,
+		RequestedCoins: requestedCoins,
+	}
+}
+
+// Validate checks that both sides of a swap give something, that at least one NFT changes hands
+// and that no NFT is listed twice
+func (swap Swap) Validate() error {
+	if swap.Proposer.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid proposer address")
+	}
+	if swap.Counterparty.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid counterparty address")
+	}
+	if swap.Proposer.Equals(swap.Counterparty) {
+		return sdkerrors.Wrap(ErrInvalidSwap, "the proposer can't swap with itself")
+	}
+	if !swap.OfferedCoins.IsValid() {
+		return sdkerrors.Wrap(ErrInvalidSwap, fmt.Sprintf("invalid offered coins %s", swap.OfferedCoins))
+	}
+	if !swap.RequestedCoins.IsValid() {
+		return sdkerrors.Wrap(ErrInvalidSwap, fmt.Sprintf("invalid requested coins %s", swap.RequestedCoins))
+	}
+	if len(swap.OfferedNFTs) == 0 && swap.OfferedCoins.Empty() {
+		return sdkerrors.Wrap(ErrInvalidSwap, "the proposer has to offer NFTs or coins")
+	}
+	if len(swap.RequestedNFTs) == 0 && swap.RequestedCoins.Empty() {
+		return sdkerrors.Wrap(ErrInvalidSwap, "the proposer has to request NFTs or coins")
+	}
+	if n := len(swap.OfferedNFTs) + len(swap.RequestedNFTs); n == 0 || n > MaxSwapSize {
+		return sdkerrors.Wrap(ErrInvalidSwap, fmt.Sprintf("a swap exchanges between 1 and %d NFTs, got %d", MaxSwapSize, n))
+	}
+
+	seen := make(map[NFTRef]bool, len(swap.OfferedNFTs)+len(swap.RequestedNFTs))
+	for _, ref := range append(append([]NFTRef{}, swap.OfferedNFTs...), swap.RequestedNFTs...) {
+		if strings.TrimSpace(ref.Denom) == "" || strings.TrimSpace(ref.ID) == "" {
+			return sdkerrors.Wrap(ErrInvalidSwap, "swapped NFT needs a denom and an ID")
+		}
+		if seen[ref] {
+			return sdkerrors.Wrap(ErrInvalidSwap, fmt.Sprintf("NFT %s is listed twice", ref))
+		}
+		seen[ref] = true
+	}
+	return nil
+}
+
+func (swap Swap) String() string {
+	return fmt.Sprintf(`ID:             %d
+Proposer:       %s
+Counterparty:   %s
+OfferedNFTs:    %s
+OfferedCoins:   %s
+RequestedNFTs:  %s
+RequestedCoins: %s`,
+		swap.ID,
+		swap.Proposer,
+		swap.Counterparty,
+		NFTRefs(swap.OfferedNFTs),
+		swap.OfferedCoins,
+		NFTRefs(swap.RequestedNFTs),
+		swap.RequestedCoins,
+	)
+}
+
+// Swaps is a list of swaps
+type Swaps []Swap
+
+func (swaps Swaps) String() string {
+	if len(swaps) == 0 {
+		return ""
+	}
+
+	out := ""
+	for _, swap := range swaps {
+		out += fmt.Sprintf("%v\n", swap.String())
+	}
+	return out[:len(out)-1]
+}
@@ -0,0 +1,226 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Trait value types
+const (
+	TraitTypeString = "string"
+	TraitTypeNumber = "number"
+	TraitTypeBool   = "bool"
+)
+
+// Trait is a typed key-value attribute of an NFT
+type Trait struct {
+	Key   string `json:"key" yaml:"key"`
+	Type  string `json:"type" yaml:"type"`
+	Value string `json:"value" yaml:"value"`
+}
+
+// NewTrait creates a new Trait instance
+func NewTrait(key, traitType, value string) Trait {
+	return Trait{
+		Key:   strings.TrimSpace(key),
+		Type:  strings.TrimSpace(traitType),
+		Value: strings.TrimSpace(value),
+	}
+}
+
+// ParseTrait parses a key=value pair and infers the type of the value
+func ParseTrait(s string) (Trait, error) {
+	kv := strings.SplitN(s, "=", 2)
+	if len(kv) != 2 {
+		return Trait{}, sdkerrors.Wrap(ErrInvalidTrait, fmt.Sprintf("%s isn't a key=value pair", s))
+	}
+	value := strings.TrimSpace(kv[1])
+	traitType := TraitTypeString
+	if value == "true" || value == "false" {
+		traitType = TraitTypeBool
+	} else if _, err := strconv.ParseFloat(value, 64); err == nil {
+		traitType = TraitTypeNumber
+	}
+	trait := NewTrait(kv[0], traitType, value)
+	return trait, trait.Validate()
+}
+
+// Validate checks that the trait has a key and a value of its type
+func (trait Trait) Validate() error {
+	if trait.Key == "" {
+		return sdkerrors.Wrap(ErrInvalidTrait, "trait key can't be empty")
+	}
+	if len(trait.Key) > MaxTraitKeyLength {
+		return sdkerrors.Wrap(ErrInvalidTrait, fmt.Sprintf("trait key can't have more than %d characters: %s", MaxTraitKeyLength, trait.Key))
+	}
+	if len(trait.Value) > MaxTraitValueLength {
+		return sdkerrors.Wrap(ErrInvalidTrait, fmt.Sprintf("value of trait %s can't have more than %d characters", trait.Key, MaxTraitValueLength))
+	}
+	switch trait.Type {
+	case TraitTypeString:
+		return nil
+	case TraitTypeNumber:
+		if _, err := strconv.ParseFloat(trait.Value, 64); err != nil {
+			return sdkerrors.Wrap(ErrInvalidTrait, fmt.Sprintf("trait %s isn't a number: %s", trait.Key, trait.Value))
+		}
+	case TraitTypeBool:
+		if trait.Value != "true" && trait.Value != "false" {
+			return sdkerrors.Wrap(ErrInvalidTrait, fmt.Sprintf("trait %s isn't a bool: %s", trait.Key, trait.Value))
+		}
+	default:
+		return sdkerrors.Wrap(ErrInvalidTrait, fmt.Sprintf("unknown type %s of trait %s", trait.Type, trait.Key))
+	}
+	return nil
+}
+
+// String follows stringer interface
+func (trait Trait) String() string {
+	return fmt.Sprintf("%s=%s (%s)", trait.Key, trait.Value, trait.Type)
+}
+
+// Traits define a list of Trait sorted by key
+type Traits []Trait
+
+// NewTraits creates a new set of Traits
+func NewTraits(traits ...Trait) Traits {
+	if len(traits) == 0 {
+		return Traits{}
+	}
+	return Traits(traits).Sort()
+}
+
+// Find returns the trait with the given key
+func (traits Traits) Find(key string) (Trait, bool) {
+	index := FindUtil(traits, key)
+	if index == -1 {
+		return Trait{}, false
+	}
+	return traits[index], true
+}
+
+// Matches returns whether every trait of the filter has the same value in the set
+func (traits Traits) Matches(filter Traits) bool {
+	for _, f := range filter {
+		trait, found := traits.Find(f.Key)
+		if !found || trait.Value != f.Value {
+			return false
+		}
+	}
+	return true
+}
+
+// Validate checks every trait and that no key is used twice
+func (traits Traits) Validate() error {
+	keys := make(map[string]bool)
+	for _, trait := range traits {
+		if err := trait.Validate(); err != nil {
+			return err
+		}
+		if keys[trait.Key] {
+			return sdkerrors.Wrap(ErrInvalidTrait, fmt.Sprintf("duplicate trait %s", trait.Key))
+		}
+		keys[trait.Key] = true
+	}
+	return nil
+}
+
+// String follows stringer interface
+func (traits Traits) String() string {
+	out := make([]string, len(traits))
+	for i, trait := range traits {
+		out[i] = trait.String()
+	}
+	return strings.Join(out, ", ")
+}
+
+// Findable and Sort interfaces
+func (traits Traits) ElAtIndex(index int) string { return traits[index].Key }
+func (traits Traits) Len() int                   { return len(traits) }
+func (traits Traits) Less(i, j int) bool         { return strings.Compare(traits[i].Key, traits[j].Key) == -1 }
+func (traits Traits) Swap(i, j int)              { traits[i], traits[j] = traits[j], traits[i] }
+
+var _ sort.Interface = Traits{}
+
+// Sort is a helper function to sort the set of traits in place
+func (traits Traits) Sort() Traits {
+	sort.Sort(traits)
+	return traits
+}
+
+// ----------------------------------------------------------------------------
+// Schema
+
+// TraitDefinition declares the type of a trait allowed in a collection
+type TraitDefinition struct {
+	Key  string `json:"key" yaml:"key"`
+	Type string `json:"type" yaml:"type"`
+}
+
+// ParseTraitDefinition parses a key:type pair
+func ParseTraitDefinition(s string) (TraitDefinition, error) {
+	kt := strings.SplitN(s, ":", 2)
+	if len(kt) != 2 {
+		return TraitDefinition{}, sdkerrors.Wrap(ErrInvalidTrait, fmt.Sprintf("%s isn't a key:type pair", s))
+	}
+	def := TraitDefinition{Key: strings.TrimSpace(kt[0]), Type: strings.TrimSpace(kt[1])}
+	return def, def.Validate()
+}
+
+// Validate checks that the definition has a key and a known type
+func (def TraitDefinition) Validate() error {
+	if def.Key == "" {
+		return sdkerrors.Wrap(ErrInvalidTrait, "trait key can't be empty")
+	}
+	switch def.Type {
+	case TraitTypeString, TraitTypeNumber, TraitTypeBool:
+		return nil
+	default:
+		return sdkerrors.Wrap(ErrInvalidTrait, fmt.Sprintf("unknown type %s of trait %s", def.Type, def.Key))
+	}
+}
+
+// TraitSchema is the optional list of traits allowed in a collection;
+// an empty schema allows any trait
+type TraitSchema []TraitDefinition
+
+// Validate checks every definition and that no key is declared twice
+func (schema TraitSchema) Validate() error {
+	keys := make(map[string]bool)
+	for _, def := range schema {
+		if err := def.Validate(); err != nil {
+			return err
+		}
+		if keys[def.Key] {
+			return sdkerrors.Wrap(ErrInvalidTrait, fmt.Sprintf("duplicate trait definition %s", def.Key))
+		}
+		keys[def.Key] = true
+	}
+	return nil
+}
+
+// Check returns an error if a trait isn't declared by the schema or has another type
+func (schema TraitSchema) Check(traits Traits) error {
+	if len(schema) == 0 {
+		return nil
+	}
+	types := make(map[string]string)
+	for _, def := range schema {
+		types[def.Key] = def.Type
+	}
+	for _, trait := range traits {
+		traitType, ok := types[trait.Key]
+		if !ok {
+			return sdkerrors.Wrap(ErrTraitNotInSchema, fmt.Sprintf("trait %s isn't declared", trait.Key))
+		}
+		if traitType != trait.Type {
+			return sdkerrors.Wrap(ErrTraitNotInSchema,
+				fmt.Sprintf("trait %s must be of type %s, got %s", trait.Key, traitType, trait.Type),
+			)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,343 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Typed events of the NFT lifecycle. Each action emits exactly one event of its type, every
+// attribute key appears once and addresses are bech32 encoded, so indexers can decode them
+// with the matching Parse function. Events read from ABCI responses are converted with
+// sdk.StringifyEvent first.
+
+// EventMintNFT is emitted when an NFT is minted, its attributes are:
+// denom, nft-id, owner, hash, proof, name, price and transfer_policy
+type EventMintNFT struct {
+	Denom          string         `json:"denom" yaml:"denom"`
+	ID             string         `json:"id" yaml:"id"`
+	Owner          sdk.AccAddress `json:"owner" yaml:"owner"`
+	Hash           string         `json:"hash" yaml:"hash"`
+	Proof          string         `json:"proof" yaml:"proof"`
+	Name           string         `json:"name" yaml:"name"`
+	Price          sdk.Coins      `json:"price" yaml:"price"`
+	TransferPolicy TransferPolicy `json:"transfer_policy" yaml:"transfer_policy"`
+}
+
+// ToEvent returns the event emitted for the mint
+func (e EventMintNFT) ToEvent() sdk.Event {
+	return sdk.NewEvent(
+		EventTypeMintNFT,
+		sdk.NewAttribute(AttributeKeyDenom, e.Denom),
+		sdk.NewAttribute(AttributeKeyNFTID, e.ID),
+		sdk.NewAttribute(AttributeKeyOwner, e.Owner.String()),
+		sdk.NewAttribute(AttributeKeyNFTHash, e.Hash),
+		sdk.NewAttribute(AttributeKeyNFTProof, e.Proof),
+		sdk.NewAttribute(AttributeKeyNFTName, e.Name),
+		sdk.NewAttribute(AttributeKeyNFTPrice, e.Price.String()),
+		sdk.NewAttribute(AttributeKeyTransferPolicy, e.TransferPolicy.String()),
+	)
+}
+
+// ParseEventMintNFT decodes a mint event
+func ParseEventMintNFT(event sdk.StringEvent) (e EventMintNFT, err error) {
+	attrs, err := eventAttributes(event, EventTypeMintNFT)
+	if err != nil {
+		return e, err
+	}
+	e.Denom, e.ID, err = attrs.ref()
+	if err != nil {
+		return e, err
+	}
+	if e.Owner, err = attrs.address(AttributeKeyOwner); err != nil {
+		return e, err
+	}
+	if e.Hash, err = attrs.get(AttributeKeyNFTHash); err != nil {
+		return e, err
+	}
+	if e.Proof, err = attrs.get(AttributeKeyNFTProof); err != nil {
+		return e, err
+	}
+	if e.Name, err = attrs.get(AttributeKeyNFTName); err != nil {
+		return e, err
+	}
+	if e.Price, err = attrs.coins(AttributeKeyNFTPrice); err != nil {
+		return e, err
+	}
+	policy, err := attrs.get(AttributeKeyTransferPolicy)
+	if err != nil {
+		return e, err
+	}
+	if e.TransferPolicy, err = ParseTransferPolicy(policy); err != nil {
+		return e, sdkerrors.Wrap(ErrInvalidEvent, err.Error())
+	}
+	return e, nil
+}
+
+// EventTransferNFT is emitted when an NFT is sent to another account, its attributes are:
+// denom, nft-id, previous_owner and new_owner
+type EventTransferNFT struct {
+	Denom         string         `json:"denom" yaml:"denom"`
+	ID            string         `json:"id" yaml:"id"`
+	PreviousOwner sdk.AccAddress `json:"previous_owner" yaml:"previous_owner"`
+	NewOwner      sdk.AccAddress `json:"new_owner" yaml:"new_owner"`
+}
+
+// ToEvent returns the event emitted for the transfer
+func (e EventTransferNFT) ToEvent() sdk.Event {
+	return sdk.NewEvent(
+		EventTypeSend,
+		sdk.NewAttribute(AttributeKeyDenom, e.Denom),
+		sdk.NewAttribute(AttributeKeyNFTID, e.ID),
+		sdk.NewAttribute(AttributeKeyPreviousOwner, e.PreviousOwner.String()),
+		sdk.NewAttribute(AttributeKeyNewOwner, e.NewOwner.String()),
+	)
+}
+
+// ParseEventTransferNFT decodes a transfer event
+func ParseEventTransferNFT(event sdk.StringEvent) (e EventTransferNFT, err error) {
+	attrs, err := eventAttributes(event, EventTypeSend)
+	if err != nil {
+		return e, err
+	}
+	e.Denom, e.ID, err = attrs.ref()
+	if err != nil {
+		return e, err
+	}
+	e.PreviousOwner, e.NewOwner, err = attrs.owners()
+	return e, err
+}
+
+// EventBuyNFT is emitted when an NFT listed for sale is bought, its attributes are:
+// denom, nft-id, previous_owner (the seller), new_owner (the buyer) and price, the amount paid
+type EventBuyNFT struct {
+	Denom         string         `json:"denom" yaml:"denom"`
+	ID            string         `json:"id" yaml:"id"`
+	PreviousOwner sdk.AccAddress `json:"previous_owner" yaml:"previous_owner"`
+	NewOwner      sdk.AccAddress `json:"new_owner" yaml:"new_owner"`
+	Price         sdk.Coins      `json:"price" yaml:"price"`
+}
+
+// ToEvent returns the event emitted for the sale
+func (e EventBuyNFT) ToEvent() sdk.Event {
+	return sdk.NewEvent(
+		EventTypeBuyNFT,
+		sdk.NewAttribute(AttributeKeyDenom, e.Denom),
+		sdk.NewAttribute(AttributeKeyNFTID, e.ID),
+		sdk.NewAttribute(AttributeKeyPreviousOwner, e.PreviousOwner.String()),
+		sdk.NewAttribute(AttributeKeyNewOwner, e.NewOwner.String()),
+		sdk.NewAttribute(AttributeKeyNFTPrice, e.Price.String()),
+	)
+}
+
+// ParseEventBuyNFT decodes a sale event
+func ParseEventBuyNFT(event sdk.StringEvent) (e EventBuyNFT, err error) {
+	attrs, err := eventAttributes(event, EventTypeBuyNFT)
+	if err != nil {
+		return e, err
+	}
+	e.Denom, e.ID, err = attrs.ref()
+	if err != nil {
+		return e, err
+	}
+	if e.PreviousOwner, e.NewOwner, err = attrs.owners(); err != nil {
+		return e, err
+	}
+	e.Price, err = attrs.coins(AttributeKeyNFTPrice)
+	return e, err
+}
+
+// EventBurnNFT is emitted when an NFT is burned, its attributes are:
+// denom, nft-id and previous_owner
+type EventBurnNFT struct {
+	Denom         string         `json:"denom" yaml:"denom"`
+	ID            string         `json:"id" yaml:"id"`
+	PreviousOwner sdk.AccAddress `json:"previous_owner" yaml:"previous_owner"`
+}
+
+// ToEvent returns the event emitted for the burn
+func (e EventBurnNFT) ToEvent() sdk.Event {
+	return sdk.NewEvent(
+		EventTypeBurnNFT,
+		sdk.NewAttribute(AttributeKeyDenom, e.Denom),
+		sdk.NewAttribute(AttributeKeyNFTID, e.ID),
+		sdk.NewAttribute(AttributeKeyPreviousOwner, e.PreviousOwner.String()),
+	)
+}
+
+// ParseEventBurnNFT decodes a burn event
+func ParseEventBurnNFT(event sdk.StringEvent) (e EventBurnNFT, err error) {
+	attrs, err := eventAttributes(event, EventTypeBurnNFT)
+	if err != nil {
+		return e, err
+	}
+	e.Denom, e.ID, err = attrs.ref()
+	if err != nil {
+		return e, err
+	}
+	e.PreviousOwner, err = attrs.address(AttributeKeyPreviousOwner)
+	return e, err
+}
+
+// EventChallengeNFT is emitted with the result of a challenge, its attributes are:
+// challenger, contender_denom, contender_id, defiant_denom, defiant_id, winner (contestant or defiant),
+// previous_owner and new_owner of the defiant, which only differ when the contender won
+type EventChallengeNFT struct {
+	Challenger    sdk.AccAddress `json:"challenger" yaml:"challenger"`
+	Contender     NFTRef         `json:"contender" yaml:"contender"`
+	Defiant       NFTRef         `json:"defiant" yaml:"defiant"`
+	Winner        string         `json:"winner" yaml:"winner"`
+	PreviousOwner sdk.AccAddress `json:"previous_owner" yaml:"previous_owner"`
+	NewOwner      sdk.AccAddress `json:"new_owner" yaml:"new_owner"`
+}
+
+// ToEvent returns the event emitted for the challenge
+func (e EventChallengeNFT) ToEvent() sdk.Event {
+	return sdk.NewEvent(
+		EventTypeChallengeNFT,
+		sdk.NewAttribute(AttributeKeyChallenger, e.Challenger.String()),
+		sdk.NewAttribute(AttributeKeyContenderDenom, e.Contender.Denom),
+		sdk.NewAttribute(AttributeKeyContenderID, e.Contender.ID),
+		sdk.NewAttribute(AttributeKeyDefiantDenom, e.Defiant.Denom),
+		sdk.NewAttribute(AttributeKeyDefiantID, e.Defiant.ID),
+		sdk.NewAttribute(AttributeKeyNFTWinner, e.Winner),
+		sdk.NewAttribute(AttributeKeyPreviousOwner, e.PreviousOwner.String()),
+		sdk.NewAttribute(AttributeKeyNewOwner, e.NewOwner.String()),
+	)
+}
+
+// ParseEventChallengeNFT decodes a challenge event
+func ParseEventChallengeNFT(event sdk.StringEvent) (e EventChallengeNFT, err error) {
+	attrs, err := eventAttributes(event, EventTypeChallengeNFT)
+	if err != nil {
+		return e, err
+	}
+	if e.Challenger, err = attrs.address(AttributeKeyChallenger); err != nil {
+		return e, err
+	}
+	for _, field := range []struct {
+		value *string
+		key   string
+	}{
+		{&e.Contender.Denom, AttributeKeyContenderDenom},
+		{&e.Contender.ID, AttributeKeyContenderID},
+		{&e.Defiant.Denom, AttributeKeyDefiantDenom},
+		{&e.Defiant.ID, AttributeKeyDefiantID},
+		{&e.Winner, AttributeKeyNFTWinner},
+	} {
+		if *field.value, err = attrs.get(field.key); err != nil {
+			return e, err
+		}
+	}
+	e.PreviousOwner, e.NewOwner, err = attrs.owners()
+	return e, err
+}
+
+// EventEditNFTPrice is emitted when the owner of an NFT changes its price, its attributes are:
+// denom, nft-id, owner, previous_price and price. An empty price removes the NFT from sale.
+type EventEditNFTPrice struct {
+	Denom         string         `json:"denom" yaml:"denom"`
+	ID            string         `json:"id" yaml:"id"`
+	Owner         sdk.AccAddress `json:"owner" yaml:"owner"`
+	PreviousPrice sdk.Coins      `json:"previous_price" yaml:"previous_price"`
+	Price         sdk.Coins      `json:"price" yaml:"price"`
+}
+
+// ToEvent returns the event emitted for the price change
+func (e EventEditNFTPrice) ToEvent() sdk.Event {
+	return sdk.NewEvent(
+		EventTypeEditNFTPrice,
+		sdk.NewAttribute(AttributeKeyDenom, e.Denom),
+		sdk.NewAttribute(AttributeKeyNFTID, e.ID),
+		sdk.NewAttribute(AttributeKeyOwner, e.Owner.String()),
+		sdk.NewAttribute(AttributeKeyPreviousPrice, e.PreviousPrice.String()),
+		sdk.NewAttribute(AttributeKeyNFTPrice, e.Price.String()),
+	)
+}
+
+// ParseEventEditNFTPrice decodes a price change event
+func ParseEventEditNFTPrice(event sdk.StringEvent) (e EventEditNFTPrice, err error) {
+	attrs, err := eventAttributes(event, EventTypeEditNFTPrice)
+	if err != nil {
+		return e, err
+	}
+	e.Denom, e.ID, err = attrs.ref()
+	if err != nil {
+		return e, err
+	}
+	if e.Owner, err = attrs.address(AttributeKeyOwner); err != nil {
+		return e, err
+	}
+	if e.PreviousPrice, err = attrs.coins(AttributeKeyPreviousPrice); err != nil {
+		return e, err
+	}
+	e.Price, err = attrs.coins(AttributeKeyNFTPrice)
+	return e, err
+}
+
+// attributes are the attributes of an event by key
+type attributes map[string]string
+
+// eventAttributes checks the type of an event and returns its attributes, which must have distinct keys
+func eventAttributes(event sdk.StringEvent, eventType string) (attributes, error) {
+	if event.Type != eventType {
+		return nil, sdkerrors.Wrap(ErrInvalidEvent, fmt.Sprintf("expected a %s event, got %s", eventType, event.Type))
+	}
+	attrs := make(attributes, len(event.Attributes))
+	for _, attr := range event.Attributes {
+		if _, ok := attrs[attr.Key]; ok {
+			return nil, sdkerrors.Wrap(ErrInvalidEvent, fmt.Sprintf("%s event has several %s attributes", eventType, attr.Key))
+		}
+		attrs[attr.Key] = attr.Value
+	}
+	return attrs, nil
+}
+
+func (attrs attributes) get(key string) (string, error) {
+	value, ok := attrs[key]
+	if !ok {
+		return "", sdkerrors.Wrap(ErrInvalidEvent, fmt.Sprintf("missing %s attribute", key))
+	}
+	return value, nil
+}
+
+func (attrs attributes) address(key string) (sdk.AccAddress, error) {
+	value, err := attrs.get(key)
+	if err != nil || value == "" {
+		return nil, err
+	}
+	address, err := sdk.AccAddressFromBech32(value)
+	if err != nil {
+		return nil, sdkerrors.Wrap(ErrInvalidEvent, fmt.Sprintf("%s attribute: %s", key, err))
+	}
+	return address, nil
+}
+
+func (attrs attributes) coins(key string) (sdk.Coins, error) {
+	value, err := attrs.get(key)
+	if err != nil {
+		return nil, err
+	}
+	coins, err := sdk.ParseCoins(value)
+	if err != nil {
+		return nil, sdkerrors.Wrap(ErrInvalidEvent, fmt.Sprintf("%s attribute: %s", key, err))
+	}
+	return coins, nil
+}
+
+func (attrs attributes) ref() (denom, id string, err error) {
+	if denom, err = attrs.get(AttributeKeyDenom); err != nil {
+		return "", "", err
+	}
+	id, err = attrs.get(AttributeKeyNFTID)
+	return denom, id, err
+}
+
+func (attrs attributes) owners() (previous, next sdk.AccAddress, err error) {
+	if previous, err = attrs.address(AttributeKeyPreviousOwner); err != nil {
+		return nil, nil, err
+	}
+	next, err = attrs.address(AttributeKeyNewOwner)
+	return previous, next, err
+}
@@ -16,9 +16,27 @@ type NFT interface {
 	GetWins() uint
 	GetLosses() uint
 	GetPrice() sdk.Coins
+	GetDescription() string
+	GetTokenURI() string
+	GetTraits() Traits
+	GetStats() Stats
+	SetStats(stats Stats)
+	IsFrozen() bool
+	Freeze()
+	GetTransferPolicy() TransferPolicy
+	SetTransferPolicy(policy TransferPolicy)
+	IsIssuerFrozen() bool
+	SetIssuerFrozen(frozen bool)
+	GetUser() sdk.AccAddress
+	GetUserExpires() int64
+	SetUser(user sdk.AccAddress, expires int64)
+	GetParent() NFTRef
+	SetParent(parent NFTRef)
+	GetCreator() sdk.AccAddress
+	SetCreator(creator sdk.AccAddress)
 	IncreaseWins()
 	IncreaseLosses()
 	EditPrice(price sdk.Coins)
-	EditMetadata(tokenName string)
+	EditMetadata(tokenName, description, tokenURI string, traits Traits)
 	String() string
 }
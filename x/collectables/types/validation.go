@@ -0,0 +1,101 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"lukechampine.com/blake3"
+)
+
+// Proof and hash constraints of a minted NFT
+const (
+	MinProofLength = 25
+	MaxProofLength = 255
+	HashLength     = 64 // hex encoded 32 byte blake3 digest
+)
+
+// Metadata constraints of an NFT
+const (
+	MaxDescriptionLength = 1024
+	MaxTokenURILength    = 512
+	MaxTraitKeyLength    = 64
+	MaxTraitValueLength  = 256
+)
+
+// ValidateProof checks that a proof has 25-255 printable ASCII characters
+func ValidateProof(proof string) error {
+	if strings.TrimSpace(proof) == "" {
+		return ErrEmptyProof
+	}
+	if len(proof) < MinProofLength || len(proof) > MaxProofLength {
+		return sdkerrors.Wrap(ErrInvalidProof,
+			fmt.Sprintf("proof must have between %d and %d characters, got %d", MinProofLength, MaxProofLength, len(proof)),
+		)
+	}
+	for i, c := range proof {
+		if c < 0x20 || c > 0x7e {
+			return sdkerrors.Wrap(ErrInvalidProof,
+				fmt.Sprintf("proof contains a non printable ASCII character at position %d", i),
+			)
+		}
+	}
+	return nil
+}
+
+// ValidateMetadata checks the length of the description and token URI of an NFT and its traits
+func ValidateMetadata(description, tokenURI string, traits Traits) error {
+	if len(description) > MaxDescriptionLength {
+		return sdkerrors.Wrap(ErrInvalidNFT,
+			fmt.Sprintf("description can't have more than %d characters, got %d", MaxDescriptionLength, len(description)),
+		)
+	}
+	if len(tokenURI) > MaxTokenURILength {
+		return sdkerrors.Wrap(ErrInvalidNFT,
+			fmt.Sprintf("token URI can't have more than %d characters, got %d", MaxTokenURILength, len(tokenURI)),
+		)
+	}
+	return traits.Validate()
+}
+
+// ValidateHash checks that a hash is a lowercase hex encoded blake3 digest
+func ValidateHash(hash string) error {
+	if len(hash) != HashLength {
+		return sdkerrors.Wrap(ErrInvalidHash,
+			fmt.Sprintf("hash must have %d hex characters, got %d", HashLength, len(hash)),
+		)
+	}
+	if strings.ToLower(hash) != hash {
+		return sdkerrors.Wrap(ErrInvalidHash, "hash must be lowercase hex")
+	}
+	if _, err := hex.DecodeString(hash); err != nil {
+		return sdkerrors.Wrap(ErrInvalidHash, err.Error())
+	}
+	return nil
+}
+
+// HashProof returns the lowercase hex encoded blake3 digest of a proof, which is the hash of the NFT minted with it
+func HashProof(proof string) string {
+	sum := blake3.Sum256([]byte(proof))
+	return hex.EncodeToString(sum[:])
+}
+
+// ValidateProofHash checks that the proof of an NFT is well formed and matches its hash. The NFTs
+// wrapping bundles are minted by the module with a fixed proof instead of a user provided one.
+func ValidateProofHash(denom string, nft NFT) error {
+	if denom == BundleDenom {
+		if nft.GetProof() != BundleProof(nft.GetID()) {
+			return sdkerrors.Wrap(ErrInvalidProof, fmt.Sprintf("bundle NFT #%s doesn't have the bundle proof", nft.GetID()))
+		}
+	} else if err := ValidateProof(nft.GetProof()); err != nil {
+		return err
+	}
+	if err := ValidateHash(nft.GetHash()); err != nil {
+		return err
+	}
+	if expected := HashProof(nft.GetProof()); nft.GetHash() != expected {
+		return sdkerrors.Wrap(ErrHashMismatch, fmt.Sprintf("NFT %s/%s has hash %s, expected %s", denom, nft.GetID(), nft.GetHash(), expected))
+	}
+	return nil
+}